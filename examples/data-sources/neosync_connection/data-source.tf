@@ -2,6 +2,10 @@ data "neosync_connection" "foo" {
   id = "3b83d1d3-5ffe-48c6-ac11-7a2e60802864"
 }
 
+data "neosync_connection" "replica" {
+  name = "prod-replica"
+}
+
 output "connection_id" {
   value = data.neosync_connection.foo.id
 }
@@ -9,3 +13,7 @@ output "connection_id" {
 output "connection_name" {
   value = data.neosync_connection.foo.name
 }
+
+output "replica_host" {
+  value = data.neosync_connection.replica.host
+}
@@ -0,0 +1,11 @@
+data "neosync_connection_foreign_keys" "source" {
+  connection_id = "3b83d1d3-5ffe-48c6-ac11-7a2e60802864"
+}
+
+output "foreign_keys" {
+  value = data.neosync_connection_foreign_keys.source.foreign_keys
+}
+
+output "primary_keys" {
+  value = data.neosync_connection_foreign_keys.source.primary_keys
+}
@@ -0,0 +1,8 @@
+data "neosync_connections" "prod_postgres" {
+  name_regex = "^prod-"
+  type       = "postgres"
+}
+
+output "prod_postgres_connection_ids" {
+  value = [for conn in data.neosync_connections.prod_postgres.connections : conn.id]
+}
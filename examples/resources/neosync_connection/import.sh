@@ -0,0 +1,5 @@
+# Import by unique identifier
+terraform import neosync_connection.example 3b83d1d3-5ffe-48c6-ac11-7a2e60802864
+
+# Import by name. The name is resolved within the provider's configured account
+terraform import neosync_connection.example name:my-connection
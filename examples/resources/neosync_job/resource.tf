@@ -9,8 +9,8 @@ resource "neosync_job" "prod_to_stage" {
       connection_id = var.prod_connection_id
     }
   }
-  destinations = [
-    {
+  destinations = {
+    stage = {
       connection_id = var.stage_connection_id
       postgres = {
         init_table_schema = false
@@ -20,7 +20,7 @@ resource "neosync_job" "prod_to_stage" {
         }
       }
     }
-  ]
+  }
 
   mappings = [
     {
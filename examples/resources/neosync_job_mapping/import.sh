@@ -0,0 +1,2 @@
+# Import by <job_id>/<schema>/<table>/<column>, escaping any / or % within the schema, table or column as %2F or %25
+terraform import neosync_job_mapping.example 3b83d1d3-5ffe-48c6-ac11-7a2e60802864/public/users/email
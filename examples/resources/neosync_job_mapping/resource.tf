@@ -0,0 +1,35 @@
+resource "neosync_job" "prod_to_stage" {
+  name = "prod-to-stage"
+
+  # mappings are managed by the neosync_job_mapping resources below
+  manage_mappings = false
+
+  source = {
+    postgres = {
+      connection_id = var.prod_connection_id
+    }
+  }
+  destinations = {
+    stage = {
+      connection_id = var.stage_connection_id
+      postgres = {
+        init_table_schema = false
+      }
+    }
+  }
+}
+
+resource "neosync_job_mapping" "users_email" {
+  job_id = neosync_job.prod_to_stage.id
+  schema = "public"
+  table  = "users"
+  column = "email"
+  transformer = {
+    config = {
+      transform_email = {
+        preserve_domain = true
+        preserve_length = false
+      }
+    }
+  }
+}
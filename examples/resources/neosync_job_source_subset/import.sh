@@ -0,0 +1,2 @@
+# Import by the job id
+terraform import neosync_job_source_subset.example 3b83d1d3-5ffe-48c6-ac11-7a2e60802864
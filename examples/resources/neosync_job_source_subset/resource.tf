@@ -0,0 +1,36 @@
+resource "neosync_job" "prod_to_stage" {
+  name = "prod-to-stage"
+
+  # subsets are managed by the neosync_job_source_subset resource below
+  manage_subsets = false
+
+  source = {
+    postgres = {
+      connection_id = var.prod_connection_id
+    }
+  }
+  destinations = {
+    stage = {
+      connection_id = var.stage_connection_id
+      postgres = {
+        init_table_schema = false
+      }
+    }
+  }
+}
+
+resource "neosync_job_source_subset" "prod_to_stage" {
+  job_id                            = neosync_job.prod_to_stage.id
+  subset_by_foreign_key_constraints = true
+  schemas = [
+    {
+      schema = "public"
+      tables = [
+        {
+          table        = "users"
+          where_clause = "created_at > now() - interval '30 days'"
+        }
+      ]
+    }
+  ]
+}
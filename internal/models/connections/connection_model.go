@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/terraform-provider-neosync/internal/models"
 )
 
 type ConnectionResourceModel struct {
@@ -13,6 +14,9 @@ type ConnectionResourceModel struct {
 	Name      types.String `tfsdk:"name"`
 	AccountId types.String `tfsdk:"account_id"`
 
+	ForceDestroy       types.Bool `tfsdk:"force_destroy"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
 	Postgres *Postgres `tfsdk:"postgres"`
 	Mysql    *Mysql    `tfsdk:"mysql"`
 	AwsS3    *AwsS3    `tfsdk:"aws_s3"`
@@ -26,6 +30,8 @@ type AwsS3 struct {
 	Credentials *AwsCredentials `tfsdk:"credentials"`
 }
 
+// The backend AWS credential dto has no web identity fields, so web identity can only be used
+// by leaving the credentials unset and relying on the worker's default credential chain.
 type AwsCredentials struct {
 	Profile         types.String `tfsdk:"profile"`
 	AccessKeyId     types.String `tfsdk:"access_key_id"`
@@ -37,7 +43,7 @@ type AwsCredentials struct {
 }
 
 type Postgres struct {
-	Url types.String `tfsdk:"url"`
+	Url ConnectionUrl `tfsdk:"url"`
 
 	Host    types.String `tfsdk:"host"`
 	Port    types.Int64  `tfsdk:"port"`
@@ -53,7 +59,7 @@ type Postgres struct {
 }
 
 type Mysql struct {
-	Url types.String `tfsdk:"url"`
+	Url ConnectionUrl `tfsdk:"url"`
 
 	Host     types.String `tfsdk:"host"`
 	Port     types.Int64  `tfsdk:"port"`
@@ -79,10 +85,10 @@ type SSHTunnel struct {
 }
 
 type SqlConnectionOptions struct {
-	MaxOpenConnections *int32  `tfsdk:"max_open_connections"`
-	MaxIdleConnections *int32  `tfsdk:"max_idle_connections"`
-	MaxIdleDuration    *string `tfsdk:"max_idle_duration"`
-	MaxOpenDuration    *string `tfsdk:"max_open_duration"`
+	MaxOpenConnections *int32          `tfsdk:"max_open_connections"`
+	MaxIdleConnections *int32          `tfsdk:"max_idle_connections"`
+	MaxIdleDuration    models.Duration `tfsdk:"max_idle_duration"`
+	MaxOpenDuration    models.Duration `tfsdk:"max_open_duration"`
 }
 
 func (c *SqlConnectionOptions) ToDto() (*mgmtv1alpha1.SqlConnectionOptions, error) {
@@ -93,8 +99,8 @@ func (c *SqlConnectionOptions) ToDto() (*mgmtv1alpha1.SqlConnectionOptions, erro
 	return &mgmtv1alpha1.SqlConnectionOptions{
 		MaxConnectionLimit: c.MaxOpenConnections,
 		MaxIdleConnections: c.MaxIdleConnections,
-		MaxIdleDuration:    c.MaxIdleDuration,
-		MaxOpenDuration:    c.MaxOpenDuration,
+		MaxIdleDuration:    c.MaxIdleDuration.ValueStringPointer(),
+		MaxOpenDuration:    c.MaxOpenDuration.ValueStringPointer(),
 	}, nil
 }
 
@@ -109,8 +115,8 @@ func (c *SqlConnectionOptions) FromDto(dto *mgmtv1alpha1.SqlConnectionOptions) e
 
 	c.MaxOpenConnections = dto.MaxConnectionLimit
 	c.MaxIdleConnections = dto.MaxIdleConnections
-	c.MaxIdleDuration = dto.MaxIdleDuration
-	c.MaxOpenDuration = dto.MaxOpenDuration
+	c.MaxIdleDuration = models.NewDurationPointerValue(dto.MaxIdleDuration)
+	c.MaxOpenDuration = models.NewDurationPointerValue(dto.MaxOpenDuration)
 
 	return nil
 }
@@ -401,7 +407,7 @@ func (c *ConnectionResourceModel) FromConnectionConfigDto(dto *mgmtv1alpha1.Conn
 			return nil
 		case *mgmtv1alpha1.PostgresConnectionConfig_Url:
 			c.Postgres = &Postgres{
-				Url:    types.StringValue(pgcc.Url),
+				Url:    NewConnectionUrlValue(pgcc.Url),
 				Tunnel: nil,
 			}
 			if config.PgConfig.Tunnel != nil {
@@ -459,7 +465,7 @@ func (c *ConnectionResourceModel) FromConnectionConfigDto(dto *mgmtv1alpha1.Conn
 			return nil
 		case *mgmtv1alpha1.MysqlConnectionConfig_Url:
 			c.Mysql = &Mysql{
-				Url:    types.StringValue(mycc.Url),
+				Url:    NewConnectionUrlValue(mycc.Url),
 				Tunnel: nil,
 			}
 			if config.MysqlConfig.Tunnel != nil {
@@ -575,3 +581,43 @@ func isAwsCredentialsEmpty(creds *mgmtv1alpha1.AwsS3Credentials) bool {
 	return creds.Profile == nil && creds.AccessKeyId == nil && creds.SecretAccessKey == nil && creds.SessionToken == nil &&
 		creds.FromEc2Role == nil && creds.RoleArn == nil && creds.RoleExternalId == nil
 }
+
+// Returns the terraform friendly type name of the connection config.
+// This lines up with the block names used on the connection resource where they exist.
+func GetConnectionType(dto *mgmtv1alpha1.ConnectionConfig) string {
+	switch dto.GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		return "postgres"
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		return "mysql"
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		return "mssql"
+	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+		return "aws_s3"
+	case *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
+		return "gcp_cloud_storage"
+	case *mgmtv1alpha1.ConnectionConfig_MongoConfig:
+		return "mongodb"
+	case *mgmtv1alpha1.ConnectionConfig_DynamodbConfig:
+		return "dynamodb"
+	case *mgmtv1alpha1.ConnectionConfig_OpenaiConfig:
+		return "openai"
+	case *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
+		return "local_dir"
+	default:
+		return "unknown"
+	}
+}
+
+// All of the connection types that may be returned by GetConnectionType.
+var ConnectionTypes = []string{
+	"postgres",
+	"mysql",
+	"mssql",
+	"aws_s3",
+	"gcp_cloud_storage",
+	"mongodb",
+	"dynamodb",
+	"openai",
+	"local_dir",
+}
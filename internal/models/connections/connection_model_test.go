@@ -0,0 +1,17 @@
+package connection_model
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetConnectionType(t *testing.T) {
+	connectionType := GetConnectionType(&mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig{GcpCloudstorageConfig: &mgmtv1alpha1.GcpCloudStorageConnectionConfig{}},
+	})
+	// matches the gcp_cloud_storage block of job destinations
+	require.Equal(t, "gcp_cloud_storage", connectionType)
+	require.Contains(t, ConnectionTypes, connectionType)
+}
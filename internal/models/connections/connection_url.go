@@ -0,0 +1,163 @@
+package connection_model
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = ConnectionUrlType{}
+	_ basetypes.StringValuableWithSemanticEquals = ConnectionUrl{}
+)
+
+// ConnectionUrlType is the attribute type for SQL connection urls.
+// Two urls are considered equal if they only differ in parameter ordering, escaping or casing of the scheme and host.
+type ConnectionUrlType struct {
+	basetypes.StringType
+}
+
+func (t ConnectionUrlType) String() string {
+	return "ConnectionUrlType"
+}
+
+func (t ConnectionUrlType) ValueType(ctx context.Context) attr.Value {
+	return ConnectionUrl{}
+}
+
+func (t ConnectionUrlType) Equal(o attr.Type) bool {
+	other, ok := o.(ConnectionUrlType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t ConnectionUrlType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ConnectionUrl{StringValue: in}, nil
+}
+
+func (t ConnectionUrlType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+type ConnectionUrl struct {
+	basetypes.StringValue
+}
+
+func NewConnectionUrlValue(value string) ConnectionUrl {
+	return ConnectionUrl{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewConnectionUrlNull() ConnectionUrl {
+	return ConnectionUrl{StringValue: basetypes.NewStringNull()}
+}
+
+func (v ConnectionUrl) Type(ctx context.Context) attr.Type {
+	return ConnectionUrlType{}
+}
+
+func (v ConnectionUrl) Equal(o attr.Value) bool {
+	other, ok := o.(ConnectionUrl)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v ConnectionUrl) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ConnectionUrl)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return ConnectionUrlsEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// Compares two connection urls by their parsed components.
+// Falls back to an exact comparison if either url can not be parsed, such as a mysql DSN.
+func ConnectionUrlsEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	canonicalA, err := canonicalizeConnectionUrl(a)
+	if err != nil {
+		return false
+	}
+	canonicalB, err := canonicalizeConnectionUrl(b)
+	if err != nil {
+		return false
+	}
+	return canonicalA == canonicalB
+}
+
+func canonicalizeConnectionUrl(input string) (string, error) {
+	u, err := url.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("%q is not a uri", input)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if scheme == "postgresql" {
+		scheme = "postgres"
+	}
+
+	var userinfo string
+	if u.User != nil {
+		userinfo = url.QueryEscape(u.User.Username())
+		if password, ok := u.User.Password(); ok {
+			userinfo += ":" + url.QueryEscape(password)
+		}
+	}
+
+	query := u.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, value := range query[key] {
+			params = append(params, url.QueryEscape(key)+"="+url.QueryEscape(value))
+		}
+	}
+
+	return fmt.Sprintf(
+		"%s://%s@%s:%s/%s?%s",
+		scheme,
+		userinfo,
+		strings.ToLower(u.Hostname()),
+		u.Port(),
+		strings.TrimPrefix(u.Path, "/"),
+		strings.Join(params, "&"),
+	), nil
+}
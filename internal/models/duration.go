@@ -0,0 +1,139 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = DurationType{}
+	_ basetypes.StringValuableWithSemanticEquals = Duration{}
+	_ xattr.ValidateableAttribute                = Duration{}
+)
+
+// DurationType is the attribute type for Go duration strings, such as "5m" or "1h30m".
+// Values are validated at plan time and two durations are considered equal if they represent the same length of time.
+type DurationType struct {
+	basetypes.StringType
+}
+
+func (t DurationType) String() string {
+	return "DurationType"
+}
+
+func (t DurationType) ValueType(ctx context.Context) attr.Value {
+	return Duration{}
+}
+
+func (t DurationType) Equal(o attr.Type) bool {
+	other, ok := o.(DurationType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DurationType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Duration{StringValue: in}, nil
+}
+
+func (t DurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+type Duration struct {
+	basetypes.StringValue
+}
+
+func NewDurationNull() Duration {
+	return Duration{StringValue: basetypes.NewStringNull()}
+}
+
+func NewDurationPointerValue(value *string) Duration {
+	return Duration{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+func (v Duration) Type(ctx context.Context) attr.Type {
+	return DurationType{}
+}
+
+func (v Duration) Equal(o attr.Value) bool {
+	other, ok := o.(Duration)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v Duration) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Duration)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	current, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	updated, err := time.ParseDuration(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return current == updated, diags
+}
+
+func (v Duration) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("%q is not a valid duration. Must be a sequence of decimal numbers with a unit suffix such as \"300ms\", \"5m\" or \"1h30m\": %s", v.ValueString(), err),
+		)
+		return
+	}
+	if duration < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("%q must not be negative", v.ValueString()),
+		)
+	}
+}
+
+// Returns the duration as a string pointer, or nil if it is null or unknown
+func (v Duration) ValueStringPointer() *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.StringValue.ValueStringPointer()
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Duration_StringSemanticEquals(t *testing.T) {
+	testcases := []struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		{"300s", "5m0s", true},
+		{"5m", "5m0s", true},
+		{"1h30m", "90m", true},
+		{"5m", "6m", false},
+		{"5 minutes", "5m", false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.a+"_"+tc.b, func(t *testing.T) {
+			a := NewDurationPointerValue(&tc.a)
+			b := NewDurationPointerValue(&tc.b)
+			equal, diags := a.StringSemanticEquals(context.Background(), b)
+			require.False(t, diags.HasError())
+			require.Equal(t, tc.expected, equal)
+		})
+	}
+}
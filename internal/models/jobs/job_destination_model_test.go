@@ -0,0 +1,124 @@
+package job_model
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nucleuscloud/terraform-provider-neosync/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JobDestination_RoundTrip(t *testing.T) {
+	period := "5s"
+	timeout := "1m"
+
+	testcases := []struct {
+		name  string
+		input *JobDestination
+	}{
+		{
+			name: "mssql defaults",
+			input: &JobDestination{
+				Id:           types.StringValue("dest-1"),
+				ConnectionId: types.StringValue("conn-1"),
+				Mssql: &JobDestinationMssqlOptions{
+					InitTableSchema:          types.BoolValue(false),
+					SkipForeignKeyViolations: types.BoolValue(false),
+					MaxInFlight:              types.Int64Null(),
+				},
+			},
+		},
+		{
+			name: "mssql",
+			input: &JobDestination{
+				Id:           types.StringValue("dest-1"),
+				ConnectionId: types.StringValue("conn-1"),
+				Mssql: &JobDestinationMssqlOptions{
+					TruncateTable:            &MssqlDestinationTruncateTable{TruncateBeforeInsert: types.BoolValue(true)},
+					InitTableSchema:          types.BoolValue(true),
+					OnConflict:               &MssqlDestinationOnConflict{Nothing: &MssqlDestinationOnConflictNothing{}},
+					SkipForeignKeyViolations: types.BoolValue(true),
+					MaxInFlight:              types.Int64Value(10),
+					Batch: &DestinationBatch{
+						Count:  types.Int64Value(100),
+						Period: models.NewDurationPointerValue(&period),
+					},
+				},
+			},
+		},
+		{
+			name: "mongodb",
+			input: &JobDestination{
+				Id:           types.StringValue("dest-1"),
+				ConnectionId: types.StringValue("conn-1"),
+				Mongodb:      &JobDestinationMongodbOptions{},
+			},
+		},
+		{
+			name: "dynamodb defaults",
+			input: &JobDestination{
+				Id:           types.StringValue("dest-1"),
+				ConnectionId: types.StringValue("conn-1"),
+				Dynamodb:     &JobDestinationDynamodbOptions{TableMappings: []*DynamodbDestinationTableMapping{}},
+			},
+		},
+		{
+			name: "dynamodb",
+			input: &JobDestination{
+				Id:           types.StringValue("dest-1"),
+				ConnectionId: types.StringValue("conn-1"),
+				Dynamodb: &JobDestinationDynamodbOptions{TableMappings: []*DynamodbDestinationTableMapping{
+					{SourceTable: types.StringValue("users"), DestinationTable: types.StringValue("users-copy")},
+				}},
+			},
+		},
+		{
+			name: "gcp cloud storage",
+			input: &JobDestination{
+				Id:              types.StringValue("dest-1"),
+				ConnectionId:    types.StringValue("conn-1"),
+				GcpCloudStorage: &JobDestinationGcpCloudStorageOptions{},
+			},
+		},
+		{
+			name: "aws s3",
+			input: &JobDestination{
+				Id:           types.StringValue("dest-1"),
+				ConnectionId: types.StringValue("conn-1"),
+				AwsS3: &JobDestinationAwsS3Options{
+					StorageClass: types.StringValue("standard"),
+					MaxInFlight:  types.Int64Value(5),
+					Timeout:      models.NewDurationPointerValue(&timeout),
+					Batch: &DestinationBatch{
+						Count:  types.Int64Null(),
+						Period: models.NewDurationPointerValue(&period),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dto, err := tc.input.ToDto()
+			require.NoError(t, err)
+
+			actual := &JobDestination{}
+			err = actual.FromDto(dto)
+			require.NoError(t, err)
+			require.Equal(t, tc.input, actual)
+		})
+	}
+}
+
+func Test_JobDestinationMssqlOptions_ToDto_MaxInFlightOutOfRange(t *testing.T) {
+	input := &JobDestinationMssqlOptions{
+		MaxInFlight: types.Int64Value(-1),
+		Batch:       &DestinationBatch{Count: types.Int64Value(-1), Period: models.NewDurationNull()},
+	}
+	dto, err := input.ToDto()
+	require.NoError(t, err)
+	require.Nil(t, dto.MssqlOptions.MaxInFlight)
+	require.Nil(t, dto.MssqlOptions.GetBatch().Count)
+	require.Nil(t, dto.MssqlOptions.GetBatch().Period)
+}
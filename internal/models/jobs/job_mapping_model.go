@@ -0,0 +1,124 @@
+package job_model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	transformer_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/transformers"
+)
+
+type JobMappingResourceModel struct {
+	Id          types.String                   `tfsdk:"id"`
+	JobId       types.String                   `tfsdk:"job_id"`
+	Schema      types.String                   `tfsdk:"schema"`
+	Table       types.String                   `tfsdk:"table"`
+	Column      types.String                   `tfsdk:"column"`
+	Transformer *transformer_model.Transformer `tfsdk:"transformer"`
+}
+
+// Only the characters that would otherwise split the id apart are escaped, so that most ids stay readable
+var (
+	jobMappingIdEscaper   = strings.NewReplacer("%", "%25", "/", "%2F")
+	jobMappingIdUnescaper = strings.NewReplacer("%2F", "/", "%2f", "/", "%25", "%")
+)
+
+// The id of a job mapping is in the form of <job_id>/<schema>/<table>/<column>.
+// Any / or % within the schema, table or column is escaped as %2F or %25.
+func NewJobMappingId(jobId, schema, table, column string) string {
+	return strings.Join([]string{
+		jobId,
+		jobMappingIdEscaper.Replace(schema),
+		jobMappingIdEscaper.Replace(table),
+		jobMappingIdEscaper.Replace(column),
+	}, "/")
+}
+
+// Splits a job mapping id into its job id, schema, table and column
+func ParseJobMappingId(id string) (jobId, schema, table, column string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 || slices.Contains(parts, "") {
+		return "", "", "", "", fmt.Errorf("%q must be in the form of <job_id>/<schema>/<table>/<column>, with any / in the schema, table or column escaped as %%2F", id)
+	}
+	return parts[0], jobMappingIdUnescaper.Replace(parts[1]), jobMappingIdUnescaper.Replace(parts[2]), jobMappingIdUnescaper.Replace(parts[3]), nil
+}
+
+func (j *JobMappingResourceModel) ToDto() (*mgmtv1alpha1.JobMapping, error) {
+	if j == nil {
+		return nil, errors.New("job mapping resource model is nil")
+	}
+	mapping := &JobMapping{
+		Schema:      j.Schema,
+		Table:       j.Table,
+		Column:      j.Column,
+		Transformer: j.Transformer,
+	}
+	return mapping.ToDto()
+}
+
+func (j *JobMappingResourceModel) FromDto(jobId string, dto *mgmtv1alpha1.JobMapping) error {
+	if j == nil {
+		return errors.New("job mapping resource model is nil")
+	}
+	if dto == nil {
+		return errors.New("job mapping dto is nil")
+	}
+
+	mapping := &JobMapping{}
+	err := mapping.FromDto(dto)
+	if err != nil {
+		return err
+	}
+
+	j.Id = types.StringValue(NewJobMappingId(jobId, dto.GetSchema(), dto.GetTable(), dto.GetColumn()))
+	j.JobId = types.StringValue(jobId)
+	j.Schema = mapping.Schema
+	j.Table = mapping.Table
+	j.Column = mapping.Column
+	j.Transformer = mapping.Transformer
+	return nil
+}
+
+// Returns the job's mapping for the given schema, table and column, or nil if the column is not mapped
+func FindJobMappingDto(mappings []*mgmtv1alpha1.JobMapping, schema, table, column string) *mgmtv1alpha1.JobMapping {
+	key := JobMappingKey{Schema: schema, Table: table, Column: column}
+	for _, mapping := range mappings {
+		if jobMappingDtoKey(mapping) == key {
+			return mapping
+		}
+	}
+	return nil
+}
+
+// Replaces the mapping for the same schema, table and column, or appends it if the column is not mapped yet
+func UpsertJobMappingDto(mappings []*mgmtv1alpha1.JobMapping, mapping *mgmtv1alpha1.JobMapping) []*mgmtv1alpha1.JobMapping {
+	output := make([]*mgmtv1alpha1.JobMapping, 0, len(mappings)+1)
+	replaced := false
+	for _, existing := range mappings {
+		if jobMappingDtoKey(existing) == jobMappingDtoKey(mapping) {
+			output = append(output, mapping)
+			replaced = true
+			continue
+		}
+		output = append(output, existing)
+	}
+	if !replaced {
+		output = append(output, mapping)
+	}
+	return output
+}
+
+// Removes the mapping for the given schema, table and column, if present
+func RemoveJobMappingDto(mappings []*mgmtv1alpha1.JobMapping, schema, table, column string) []*mgmtv1alpha1.JobMapping {
+	key := JobMappingKey{Schema: schema, Table: table, Column: column}
+	output := make([]*mgmtv1alpha1.JobMapping, 0, len(mappings))
+	for _, existing := range mappings {
+		if jobMappingDtoKey(existing) != key {
+			output = append(output, existing)
+		}
+	}
+	return output
+}
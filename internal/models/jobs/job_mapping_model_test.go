@@ -0,0 +1,65 @@
+package job_model
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseJobMappingId(t *testing.T) {
+	jobId, schema, table, column, err := ParseJobMappingId(NewJobMappingId("job-1", "public", "users", "email"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"job-1", "public", "users", "email"}, []string{jobId, schema, table, column})
+
+	id := NewJobMappingId("job-1", "reports/2024", "100%", "a/b")
+	require.Equal(t, "job-1/reports%2F2024/100%25/a%2Fb", id)
+	jobId, schema, table, column, err = ParseJobMappingId(id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"job-1", "reports/2024", "100%", "a/b"}, []string{jobId, schema, table, column})
+
+	_, _, _, _, err = ParseJobMappingId("job-1/public/users")
+	require.Error(t, err)
+	_, _, _, _, err = ParseJobMappingId("job-1/public/users/a/b")
+	require.Error(t, err)
+	_, _, _, _, err = ParseJobMappingId("job-1//users/email")
+	require.Error(t, err)
+}
+
+func Test_UpsertJobMappingDto(t *testing.T) {
+	mappings := []*mgmtv1alpha1.JobMapping{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "public", Table: "users", Column: "email"},
+	}
+	email := &mgmtv1alpha1.JobMapping{Schema: "public", Table: "users", Column: "email", Transformer: &mgmtv1alpha1.JobMappingTransformer{}}
+	name := &mgmtv1alpha1.JobMapping{Schema: "public", Table: "users", Column: "name"}
+
+	updated := UpsertJobMappingDto(mappings, email)
+	require.Len(t, updated, 2)
+	require.Same(t, email, updated[1])
+
+	updated = UpsertJobMappingDto(updated, name)
+	require.Len(t, updated, 3)
+	require.Same(t, name, updated[2])
+
+	updated = RemoveJobMappingDto(updated, "public", "users", "email")
+	require.Len(t, updated, 2)
+	require.Nil(t, FindJobMappingDto(updated, "public", "users", "email"))
+	require.Same(t, name, FindJobMappingDto(updated, "public", "users", "name"))
+}
+
+func Test_UpsertJobMappingDto_DottedIdentifiers(t *testing.T) {
+	dottedSchema := &mgmtv1alpha1.JobMapping{Schema: "a.b", Table: "c", Column: "id"}
+	dottedTable := &mgmtv1alpha1.JobMapping{Schema: "a", Table: "b.c", Column: "id"}
+	mappings := []*mgmtv1alpha1.JobMapping{dottedSchema, dottedTable}
+
+	replacement := &mgmtv1alpha1.JobMapping{Schema: "a", Table: "b.c", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{}}
+	updated := UpsertJobMappingDto(mappings, replacement)
+	require.Len(t, updated, 2)
+	require.Same(t, dottedSchema, updated[0])
+	require.Same(t, replacement, updated[1])
+
+	updated = RemoveJobMappingDto(updated, "a.b", "c", "id")
+	require.Len(t, updated, 1)
+	require.Same(t, replacement, updated[0])
+}
@@ -1,12 +1,18 @@
 package job_model
 
 import (
+	"cmp"
 	"errors"
+	"fmt"
 	"math"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/terraform-provider-neosync/internal/models"
 	transformer_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/transformers"
+	"google.golang.org/protobuf/proto"
 )
 
 type JobResourceModel struct {
@@ -14,12 +20,16 @@ type JobResourceModel struct {
 	Name               types.String                   `tfsdk:"name"`
 	AccountId          types.String                   `tfsdk:"account_id"`
 	JobSource          *JobSource                     `tfsdk:"source"`
-	Destinations       []*JobDestination              `tfsdk:"destinations"`
+	Destinations       map[string]*JobDestination     `tfsdk:"destinations"`
 	Mappings           []*JobMapping                  `tfsdk:"mappings"`
 	CronSchedule       types.String                   `tfsdk:"cron_schedule"`
 	SyncOptions        *ActivityOptions               `tfsdk:"sync_options"`
 	WorkflowOptions    *WorkflowOptions               `tfsdk:"workflow_options"`
 	VirtualForeignKeys []*VirtualForeignKeyConstraint `tfsdk:"virtual_foreign_keys"`
+	DeletionProtection types.Bool                     `tfsdk:"deletion_protection"`
+	ManageMappings     types.Bool                     `tfsdk:"manage_mappings"`
+	ManageSubsets      types.Bool                     `tfsdk:"manage_subsets"`
+	Paused             types.Bool                     `tfsdk:"paused"`
 }
 
 type VirtualForeignKeyConstraint struct {
@@ -38,9 +48,13 @@ type VirtualForeignKey struct {
 type JobSource struct {
 	Postgres *JobSourcePostgresOptions `tfsdk:"postgres"`
 	Mysql    *JobSourceMysqlOptions    `tfsdk:"mysql"`
+	Mssql    *JobSourceMssqlOptions    `tfsdk:"mssql"`
 	Generate *JobSourceGenerateOptions `tfsdk:"generate"`
 	AwsS3    *JobSourceAwsS3Options    `tfsdk:"aws_s3"`
-	// mssql, ai generate
+	Mongodb  *JobSourceMongodbOptions  `tfsdk:"mongodb"`
+	Dynamodb *JobSourceDynamodbOptions `tfsdk:"dynamodb"`
+
+	AiGenerate *JobSourceAiGenerateOptions `tfsdk:"ai_generate"`
 }
 type JobSourcePostgresOptions struct {
 	NewColumnAdditionStrategy     *PostgresNewColumnAdditionStrategy     `tfsdk:"new_column_addition_strategy"`
@@ -78,14 +92,21 @@ type JobSourceMysqlOptions struct {
 	ConnectionId                  types.String                        `tfsdk:"connection_id"`
 	SchemaOptions                 []*JobSourceMysqlSourceSchemaOption `tfsdk:"schemas"`
 	SubsetByForeignKeyConstraints types.Bool                          `tfsdk:"subset_by_foreign_key_constraints"`
-	ColumnRemovalStrategy         *MssqlColumnRemovalStrategy         `tfsdk:"column_removal_strategy"`
+	NewColumnAdditionStrategy     *MysqlNewColumnAdditionStrategy     `tfsdk:"new_column_addition_strategy"`
+	ColumnRemovalStrategy         *MysqlColumnRemovalStrategy         `tfsdk:"column_removal_strategy"`
 }
-type MssqlColumnRemovalStrategy struct {
-	HaltJob     *MssqlHaltJobColumnRemovalStrategy     `json:"haltJob,omitempty"`
-	ContinueJob *MssqlContinueJobColumnRemovalStrategy `json:"continueJob,omitempty"`
+
+// The backend only supports halting mysql jobs on new columns, the default is to continue.
+type MysqlNewColumnAdditionStrategy struct {
+	HaltJob *MysqlNewColumnAdditionStrategyHaltJob `tfsdk:"halt_job"`
 }
-type MssqlHaltJobColumnRemovalStrategy struct{}
-type MssqlContinueJobColumnRemovalStrategy struct{}
+type MysqlNewColumnAdditionStrategyHaltJob struct{}
+type MysqlColumnRemovalStrategy struct {
+	HaltJob     *MysqlHaltJobColumnRemovalStrategy     `tfsdk:"halt_job"`
+	ContinueJob *MysqlContinueJobColumnRemovalStrategy `tfsdk:"continue_job"`
+}
+type MysqlHaltJobColumnRemovalStrategy struct{}
+type MysqlContinueJobColumnRemovalStrategy struct{}
 
 type JobSourceMysqlSourceSchemaOption struct {
 	Schema types.String                       `tfsdk:"schema"`
@@ -108,35 +129,158 @@ type JobSourceGenerateTableOption struct {
 	Table    types.String `tfsdk:"table"`
 	RowCount types.Int64  `tfsdk:"row_count"`
 }
+type JobSourceAiGenerateOptions struct {
+	AiConnectionId       types.String                       `tfsdk:"ai_connection_id"`
+	FkSourceConnectionId types.String                       `tfsdk:"fk_source_connection_id"`
+	ModelName            types.String                       `tfsdk:"model_name"`
+	UserPrompt           types.String                       `tfsdk:"user_prompt"`
+	GenerateBatchSize    types.Int64                        `tfsdk:"generate_batch_size"`
+	Schemas              []*JobSourceAiGenerateSchemaOption `tfsdk:"schemas"`
+}
+type JobSourceAiGenerateSchemaOption struct {
+	Schema types.String                      `tfsdk:"schema"`
+	Tables []*JobSourceAiGenerateTableOption `tfsdk:"tables"`
+}
+type JobSourceAiGenerateTableOption struct {
+	Table    types.String `tfsdk:"table"`
+	RowCount types.Int64  `tfsdk:"row_count"`
+}
 type JobSourceAwsS3Options struct {
 	ConnectionId types.String `tfsdk:"connection_id"`
 }
 
+type JobSourceMssqlOptions struct {
+	ConnectionId                  types.String                        `tfsdk:"connection_id"`
+	SchemaOptions                 []*JobSourceMssqlSourceSchemaOption `tfsdk:"schemas"`
+	SubsetByForeignKeyConstraints types.Bool                          `tfsdk:"subset_by_foreign_key_constraints"`
+	NewColumnAdditionStrategy     *MssqlNewColumnAdditionStrategy     `tfsdk:"new_column_addition_strategy"`
+	ColumnRemovalStrategy         *MssqlColumnRemovalStrategy         `tfsdk:"column_removal_strategy"`
+}
+
+// The backend only supports halting mssql jobs on new columns, the default is to continue.
+type MssqlNewColumnAdditionStrategy struct {
+	HaltJob *MssqlNewColumnAdditionStrategyHaltJob `tfsdk:"halt_job"`
+}
+type MssqlNewColumnAdditionStrategyHaltJob struct{}
+
+type MssqlColumnRemovalStrategy struct {
+	HaltJob     *MssqlHaltJobColumnRemovalStrategy     `tfsdk:"halt_job"`
+	ContinueJob *MssqlContinueJobColumnRemovalStrategy `tfsdk:"continue_job"`
+}
+type MssqlHaltJobColumnRemovalStrategy struct{}
+type MssqlContinueJobColumnRemovalStrategy struct{}
+type JobSourceMssqlSourceSchemaOption struct {
+	Schema types.String                       `tfsdk:"schema"`
+	Tables []*JobSourceMssqlSourceTableOption `tfsdk:"tables"`
+}
+type JobSourceMssqlSourceTableOption struct {
+	Table       types.String `tfsdk:"table"`
+	WhereClause types.String `tfsdk:"where_clause"`
+}
+
+type JobSourceMongodbOptions struct {
+	ConnectionId types.String `tfsdk:"connection_id"`
+}
+
+type JobSourceDynamodbOptions struct {
+	ConnectionId         types.String                     `tfsdk:"connection_id"`
+	Tables               []*JobSourceDynamodbTableOption  `tfsdk:"tables"`
+	UnmappedTransforms   *DynamodbUnmappedTransformConfig `tfsdk:"unmapped_transforms"`
+	EnableConsistentRead types.Bool                       `tfsdk:"enable_consistent_read"`
+}
+type JobSourceDynamodbTableOption struct {
+	Table       types.String `tfsdk:"table"`
+	WhereClause types.String `tfsdk:"where_clause"`
+}
+type DynamodbUnmappedTransformConfig struct {
+	B       *transformer_model.Transformer `tfsdk:"b"`
+	Boolean *transformer_model.Transformer `tfsdk:"boolean"`
+	N       *transformer_model.Transformer `tfsdk:"n"`
+	S       *transformer_model.Transformer `tfsdk:"s"`
+}
+
 type JobDestination struct {
 	Id           types.String `tfsdk:"id"`
 	ConnectionId types.String `tfsdk:"connection_id"`
 
-	Postgres *JobDestinationPostgresOptions `tfsdk:"postgres"`
-	Mysql    *JobDestinationMysqlOptions    `tfsdk:"mysql"`
-	AwsS3    *JobDestinationAwsS3Options    `tfsdk:"aws_s3"`
-	// todo: fill out remaining destinations
+	Postgres        *JobDestinationPostgresOptions        `tfsdk:"postgres"`
+	Mysql           *JobDestinationMysqlOptions           `tfsdk:"mysql"`
+	Mssql           *JobDestinationMssqlOptions           `tfsdk:"mssql"`
+	AwsS3           *JobDestinationAwsS3Options           `tfsdk:"aws_s3"`
+	Mongodb         *JobDestinationMongodbOptions         `tfsdk:"mongodb"`
+	Dynamodb        *JobDestinationDynamodbOptions        `tfsdk:"dynamodb"`
+	GcpCloudStorage *JobDestinationGcpCloudStorageOptions `tfsdk:"gcp_cloud_storage"`
 }
 type JobDestinationPostgresOptions struct {
-	TruncateTable   *PostgresDestinationTruncateTable `tfsdk:"truncate_table"`
-	InitTableSchema types.Bool                        `tfsdk:"init_table_schema"`
+	TruncateTable            *PostgresDestinationTruncateTable `tfsdk:"truncate_table"`
+	InitTableSchema          types.Bool                        `tfsdk:"init_table_schema"`
+	OnConflict               *PostgresDestinationOnConflict    `tfsdk:"on_conflict"`
+	SkipForeignKeyViolations types.Bool                        `tfsdk:"skip_foreign_key_violations"`
+	MaxInFlight              types.Int64                       `tfsdk:"max_in_flight"`
+	Batch                    *DestinationBatch                 `tfsdk:"batch"`
 }
 type PostgresDestinationTruncateTable struct {
 	TruncateBeforeInsert types.Bool `tfsdk:"truncate_before_insert"`
 	Cascade              types.Bool `tfsdk:"cascade"`
 }
+type PostgresDestinationOnConflict struct {
+	Nothing *PostgresDestinationOnConflictNothing `tfsdk:"nothing"`
+	Update  *PostgresDestinationOnConflictUpdate  `tfsdk:"update"`
+}
+type PostgresDestinationOnConflictNothing struct{}
+type PostgresDestinationOnConflictUpdate struct{}
+
+type DestinationBatch struct {
+	Count  types.Int64     `tfsdk:"count"`
+	Period models.Duration `tfsdk:"period"`
+}
 type JobDestinationMysqlOptions struct {
-	TruncateTable   *MysqlDestinationTruncateTable `tfsdk:"truncate_table"`
-	InitTableSchema types.Bool                     `tfsdk:"init_table_schema"`
+	TruncateTable            *MysqlDestinationTruncateTable `tfsdk:"truncate_table"`
+	InitTableSchema          types.Bool                     `tfsdk:"init_table_schema"`
+	OnConflict               *MysqlDestinationOnConflict    `tfsdk:"on_conflict"`
+	SkipForeignKeyViolations types.Bool                     `tfsdk:"skip_foreign_key_violations"`
+	MaxInFlight              types.Int64                    `tfsdk:"max_in_flight"`
+	Batch                    *DestinationBatch              `tfsdk:"batch"`
 }
 type MysqlDestinationTruncateTable struct {
 	TruncateBeforeInsert types.Bool `tfsdk:"truncate_before_insert"`
 }
-type JobDestinationAwsS3Options struct{}
+type MysqlDestinationOnConflict struct {
+	Nothing *MysqlDestinationOnConflictNothing `tfsdk:"nothing"`
+	Update  *MysqlDestinationOnConflictUpdate  `tfsdk:"update"`
+}
+type MysqlDestinationOnConflictNothing struct{}
+type MysqlDestinationOnConflictUpdate struct{}
+type JobDestinationAwsS3Options struct {
+	StorageClass types.String      `tfsdk:"storage_class"`
+	MaxInFlight  types.Int64       `tfsdk:"max_in_flight"`
+	Timeout      models.Duration   `tfsdk:"timeout"`
+	Batch        *DestinationBatch `tfsdk:"batch"`
+}
+type JobDestinationMssqlOptions struct {
+	TruncateTable            *MssqlDestinationTruncateTable `tfsdk:"truncate_table"`
+	InitTableSchema          types.Bool                     `tfsdk:"init_table_schema"`
+	OnConflict               *MssqlDestinationOnConflict    `tfsdk:"on_conflict"`
+	SkipForeignKeyViolations types.Bool                     `tfsdk:"skip_foreign_key_violations"`
+	MaxInFlight              types.Int64                    `tfsdk:"max_in_flight"`
+	Batch                    *DestinationBatch              `tfsdk:"batch"`
+}
+type MssqlDestinationTruncateTable struct {
+	TruncateBeforeInsert types.Bool `tfsdk:"truncate_before_insert"`
+}
+type MssqlDestinationOnConflict struct {
+	Nothing *MssqlDestinationOnConflictNothing `tfsdk:"nothing"`
+}
+type MssqlDestinationOnConflictNothing struct{}
+type JobDestinationMongodbOptions struct{}
+type JobDestinationDynamodbOptions struct {
+	TableMappings []*DynamodbDestinationTableMapping `tfsdk:"table_mappings"`
+}
+type DynamodbDestinationTableMapping struct {
+	SourceTable      types.String `tfsdk:"source_table"`
+	DestinationTable types.String `tfsdk:"destination_table"`
+}
+type JobDestinationGcpCloudStorageOptions struct{}
 
 type JobMapping struct {
 	Schema      types.String                   `tfsdk:"schema"`
@@ -145,6 +289,26 @@ type JobMapping struct {
 	Transformer *transformer_model.Transformer `tfsdk:"transformer"`
 }
 
+// Identifies a job mapping by its schema, table and column.
+// The parts are kept separate as identifiers may themselves contain dots.
+type JobMappingKey struct {
+	Schema string
+	Table  string
+	Column string
+}
+
+func (k JobMappingKey) String() string {
+	return fmt.Sprintf("%s.%s.%s", k.Schema, k.Table, k.Column)
+}
+
+func (k JobMappingKey) Compare(other JobMappingKey) int {
+	return cmp.Or(
+		strings.Compare(k.Schema, other.Schema),
+		strings.Compare(k.Table, other.Table),
+		strings.Compare(k.Column, other.Column),
+	)
+}
+
 type ActivityOptions struct {
 	ScheduleToCloseTimeout types.Int64  `tfsdk:"schedule_to_close_timeout"`
 	StartToCloseTimeout    types.Int64  `tfsdk:"start_to_close_timeout"`
@@ -162,7 +326,7 @@ func (j *JobResourceModel) ToCreateJobDto() (*mgmtv1alpha1.CreateJobRequest, err
 		return nil, errors.New("job resource model is nil")
 	}
 
-	mappings, err := ToJobMappingsDto(j.Mappings)
+	mappings, err := j.toJobMappingsDto()
 	if err != nil {
 		return nil, err
 	}
@@ -190,8 +354,8 @@ func (j *JobResourceModel) ToCreateJobDto() (*mgmtv1alpha1.CreateJobRequest, err
 	var destinations []*mgmtv1alpha1.CreateJobDestination
 	if len(j.Destinations) > 0 {
 		destinations = make([]*mgmtv1alpha1.CreateJobDestination, 0, len(j.Destinations))
-		for _, destination := range j.Destinations {
-			destinationDto, err := destination.ToCreateJobDestinationDto()
+		for _, key := range sortedDestinationKeys(j.Destinations) {
+			destinationDto, err := j.Destinations[key].ToCreateJobDestinationDto()
 			if err != nil {
 				return nil, err
 			}
@@ -232,6 +396,15 @@ type UpdateJobRequest struct {
 	CreateJobDestinationConnectionsRequest *mgmtv1alpha1.CreateJobDestinationConnectionsRequest
 	UpdateJobDestinationConnectionRequests []*mgmtv1alpha1.UpdateJobDestinationConnectionRequest
 	DeleteJobDestinationConnectionRequests []*mgmtv1alpha1.DeleteJobDestinationConnectionRequest
+
+	SetJobSyncOptionsRequest     *mgmtv1alpha1.SetJobSyncOptionsRequest
+	SetJobWorkflowOptionsRequest *mgmtv1alpha1.SetJobWorkflowOptionsRequest
+	PauseJobRequest              *mgmtv1alpha1.PauseJobRequest
+
+	// The job's mappings are not managed by the job resource, so the source connection update must keep the job's current mappings
+	KeepCurrentMappings bool
+	// The job's subsets are not managed by the job resource, so the source connection update must keep the job's current subsets
+	KeepCurrentSubsets bool
 }
 
 func (j *JobResourceModel) ToUpdateJobDto(planModel *JobResourceModel, jobId string) (*UpdateJobRequest, error) {
@@ -242,62 +415,112 @@ func (j *JobResourceModel) ToUpdateJobDto(planModel *JobResourceModel, jobId str
 		return nil, errors.New("plan model is nil")
 	}
 	var updateJobScheduleRequest *mgmtv1alpha1.UpdateJobScheduleRequest
-	if j.CronSchedule.ValueString() != planModel.CronSchedule.ValueString() {
+	if !planModel.CronSchedule.IsUnknown() && j.CronSchedule.ValueString() != planModel.CronSchedule.ValueString() {
 		updateJobScheduleRequest = &mgmtv1alpha1.UpdateJobScheduleRequest{
 			Id:           j.Id.ValueString(),
 			CronSchedule: planModel.CronSchedule.ValueStringPointer(),
 		}
 	}
 
-	newSource, err := planModel.JobSource.ToDto()
+	updateJobSourceConnectionRequest, err := planModel.toUpdateJobSourceConnectionRequest(j.Id.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	currentJobSourceConnectionRequest, err := j.toUpdateJobSourceConnectionRequest(j.Id.ValueString())
 	if err != nil {
 		return nil, err
 	}
+	if proto.Equal(sortedJobMappingsRequest(updateJobSourceConnectionRequest), sortedJobMappingsRequest(currentJobSourceConnectionRequest)) {
+		updateJobSourceConnectionRequest = nil
+	}
 
-	newMappings, err := ToJobMappingsDto(planModel.Mappings)
+	var setJobSyncOptionsRequest *mgmtv1alpha1.SetJobSyncOptionsRequest
+	planSyncOpts, err := planModel.toSyncOptionsDto()
+	if err != nil {
+		return nil, err
+	}
+	stateSyncOpts, err := j.toSyncOptionsDto()
 	if err != nil {
 		return nil, err
 	}
+	if !proto.Equal(planSyncOpts, stateSyncOpts) {
+		setJobSyncOptionsRequest = &mgmtv1alpha1.SetJobSyncOptionsRequest{
+			Id:          j.Id.ValueString(),
+			SyncOptions: planSyncOpts,
+		}
+	}
 
-	var virtualForeignKeys []*mgmtv1alpha1.VirtualForeignConstraint
-	if len(planModel.VirtualForeignKeys) > 0 {
-		virtualForeignKeys = make([]*mgmtv1alpha1.VirtualForeignConstraint, 0, len(planModel.VirtualForeignKeys))
-		for _, vfk := range planModel.VirtualForeignKeys {
-			vfkDto, err := vfk.ToDto()
-			if err != nil {
-				return nil, err
-			}
-			virtualForeignKeys = append(virtualForeignKeys, vfkDto)
+	var setJobWorkflowOptionsRequest *mgmtv1alpha1.SetJobWorkflowOptionsRequest
+	planWorkflowOpts, err := planModel.toWorkflowOptionsDto()
+	if err != nil {
+		return nil, err
+	}
+	stateWorkflowOpts, err := j.toWorkflowOptionsDto()
+	if err != nil {
+		return nil, err
+	}
+	if !proto.Equal(planWorkflowOpts, stateWorkflowOpts) {
+		setJobWorkflowOptionsRequest = &mgmtv1alpha1.SetJobWorkflowOptionsRequest{
+			Id:              j.Id.ValueString(),
+			WorfklowOptions: planWorkflowOpts,
 		}
 	}
 
-	// todo: compare plan and state and only conditionally create request if there are actually changes
-	updateJobSourceConnectionRequest := &mgmtv1alpha1.UpdateJobSourceConnectionRequest{
-		Id:                 j.Id.ValueString(),
-		Source:             newSource,
-		Mappings:           newMappings,
-		VirtualForeignKeys: virtualForeignKeys,
+	// paused is only sent when it is configured, otherwise the job's current status is kept
+	var pauseJobRequest *mgmtv1alpha1.PauseJobRequest
+	if !planModel.Paused.IsNull() && !planModel.Paused.IsUnknown() && !planModel.Paused.Equal(j.Paused) {
+		pauseJobRequest = &mgmtv1alpha1.PauseJobRequest{
+			Id:    j.Id.ValueString(),
+			Pause: planModel.Paused.ValueBool(),
+		}
 	}
 
+	// destinations are paired by their key so that reordering or adding destinations does not cause churn.
+	// Any that are left over are then paired by their connection id, so that renaming a key, such as the
+	// connection id keys written by the state upgrader, updates the destination in place.
 	destinationsToCreate := []*JobDestination{}
 	destinationsToUpdate := []*JobDestination{}
 	destinationsToDelete := []*JobDestination{}
 
-	stateDestinationsMap := map[string]*JobDestination{}
-	for _, dst := range j.Destinations {
-		stateDestinationsMap[dst.Id.ValueString()] = dst
+	unpairedPlanKeys := []string{}
+	pairedStateKeys := map[string]bool{}
+	for _, key := range sortedDestinationKeys(planModel.Destinations) {
+		stateDst, ok := j.Destinations[key]
+		if ok {
+			pairedStateKeys[key] = true
+		}
+		if !ok || stateDst.Id.ValueString() == "" {
+			unpairedPlanKeys = append(unpairedPlanKeys, key)
+			continue
+		}
+		updated, err := planModel.Destinations[key].updatedFrom(stateDst)
+		if err != nil {
+			return nil, err
+		}
+		if updated != nil {
+			destinationsToUpdate = append(destinationsToUpdate, updated)
+		}
 	}
-
-	for _, dst := range planModel.Destinations {
-		if dst.Id.IsUnknown() {
+	for _, key := range unpairedPlanKeys {
+		dst := planModel.Destinations[key]
+		stateKey, ok := findUnpairedDestinationByConnectionId(j.Destinations, pairedStateKeys, dst.ConnectionId)
+		if !ok {
 			destinationsToCreate = append(destinationsToCreate, dst)
 			continue
 		}
-		if _, ok := stateDestinationsMap[dst.Id.ValueString()]; !ok {
-			destinationsToDelete = append(destinationsToDelete, dst)
-			continue
+		pairedStateKeys[stateKey] = true
+		updated, err := dst.updatedFrom(j.Destinations[stateKey])
+		if err != nil {
+			return nil, err
+		}
+		if updated != nil {
+			destinationsToUpdate = append(destinationsToUpdate, updated)
+		}
+	}
+	for _, key := range sortedDestinationKeys(j.Destinations) {
+		if !pairedStateKeys[key] {
+			destinationsToDelete = append(destinationsToDelete, j.Destinations[key])
 		}
-		destinationsToUpdate = append(destinationsToUpdate, dst) // todo: should do work here to see if it has actually changed at all
 	}
 
 	var createJobDestinationConnectionsRequest *mgmtv1alpha1.CreateJobDestinationConnectionsRequest
@@ -346,9 +569,305 @@ func (j *JobResourceModel) ToUpdateJobDto(planModel *JobResourceModel, jobId str
 		CreateJobDestinationConnectionsRequest: createJobDestinationConnectionsRequest,
 		UpdateJobDestinationConnectionRequests: updateJobDestinationConnectionRequests,
 		DeleteJobDestinationConnectionRequests: deleteJobDestinationConnectionRequests,
+		SetJobSyncOptionsRequest:               setJobSyncOptionsRequest,
+		SetJobWorkflowOptionsRequest:           setJobWorkflowOptionsRequest,
+		PauseJobRequest:                        pauseJobRequest,
+		KeepCurrentMappings:                    !planModel.ManagesMappings(),
+		KeepCurrentSubsets:                     !planModel.ManagesSubsets(),
+	}, nil
+}
+
+// Returns the planned destination with the id of the state destination it was paired with, or nil if nothing changed.
+// The state id is used even if the planned id is unknown, as the destination has already been identified.
+func (j *JobDestination) updatedFrom(stateDst *JobDestination) (*JobDestination, error) {
+	updated := *j
+	updated.Id = stateDst.Id
+	changed, err := updated.hasChanged(stateDst)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return nil, nil
+	}
+	return &updated, nil
+}
+
+// Returns the key of the first state destination that has not been paired yet and uses the given connection
+func findUnpairedDestinationByConnectionId(destinations map[string]*JobDestination, paired map[string]bool, connectionId types.String) (string, bool) {
+	if connectionId.IsNull() || connectionId.IsUnknown() {
+		return "", false
+	}
+	for _, key := range sortedDestinationKeys(destinations) {
+		dst := destinations[key]
+		if paired[key] || dst.Id.ValueString() == "" {
+			continue
+		}
+		if dst.ConnectionId.Equal(connectionId) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// The options are always sent in full as the backend overwrites the previous value.
+// A removed block is sent as empty options to clear them.
+func (j *JobResourceModel) toSyncOptionsDto() (*mgmtv1alpha1.ActivityOptions, error) {
+	if j.SyncOptions == nil {
+		return &mgmtv1alpha1.ActivityOptions{}, nil
+	}
+	return j.SyncOptions.ToDto()
+}
+
+func (j *JobResourceModel) toWorkflowOptionsDto() (*mgmtv1alpha1.WorkflowOptions, error) {
+	if j.WorkflowOptions == nil {
+		return &mgmtv1alpha1.WorkflowOptions{}, nil
+	}
+	return j.WorkflowOptions.ToDto()
+}
+
+// Returns a copy of the request with its mappings sorted by schema, table and column so that requests can be compared regardless of mapping order.
+func sortedJobMappingsRequest(req *mgmtv1alpha1.UpdateJobSourceConnectionRequest) *mgmtv1alpha1.UpdateJobSourceConnectionRequest {
+	sorted, ok := proto.Clone(req).(*mgmtv1alpha1.UpdateJobSourceConnectionRequest)
+	if !ok {
+		return req
+	}
+	slices.SortStableFunc(sorted.Mappings, func(a, b *mgmtv1alpha1.JobMapping) int {
+		return jobMappingDtoKey(a).Compare(jobMappingDtoKey(b))
+	})
+	return sorted
+}
+
+func jobMappingDtoKey(mapping *mgmtv1alpha1.JobMapping) JobMappingKey {
+	return JobMappingKey{Schema: mapping.GetSchema(), Table: mapping.GetTable(), Column: mapping.GetColumn()}
+}
+
+// Orders the mappings to follow the order of a prior model, such as the plan or the current state.
+// Mappings are matched by their schema, table and column, and any mappings that were not present before are placed at the end.
+func (j *JobResourceModel) orderMappingsLike(prior []*JobMapping) {
+	if j == nil || len(j.Mappings) == 0 || len(prior) == 0 {
+		return
+	}
+
+	positions := make(map[JobMappingKey]int, len(prior))
+	for idx, mapping := range prior {
+		if _, ok := positions[mapping.key()]; !ok {
+			positions[mapping.key()] = idx
+		}
+	}
+	slices.SortStableFunc(j.Mappings, func(a, b *JobMapping) int {
+		aPos, aOk := positions[a.key()]
+		bPos, bOk := positions[b.key()]
+		switch {
+		case aOk && bOk:
+			return aPos - bPos
+		case aOk:
+			return -1
+		case bOk:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+func (m *JobMapping) key() JobMappingKey {
+	return JobMappingKey{Schema: m.Schema.ValueString(), Table: m.Table.ValueString(), Column: m.Column.ValueString()}
+}
+
+func (j *JobResourceModel) toUpdateJobSourceConnectionRequest(jobId string) (*mgmtv1alpha1.UpdateJobSourceConnectionRequest, error) {
+	jobSource := j.JobSource
+	if !j.ManagesSubsets() {
+		jobSource = jobSource.withoutSubsets()
+	}
+	source, err := jobSource.ToDto()
+	if err != nil {
+		return nil, err
+	}
+
+	mappings, err := j.toJobMappingsDto()
+	if err != nil {
+		return nil, err
+	}
+
+	var virtualForeignKeys []*mgmtv1alpha1.VirtualForeignConstraint
+	if len(j.VirtualForeignKeys) > 0 {
+		virtualForeignKeys = make([]*mgmtv1alpha1.VirtualForeignConstraint, 0, len(j.VirtualForeignKeys))
+		for _, vfk := range j.VirtualForeignKeys {
+			vfkDto, err := vfk.ToDto()
+			if err != nil {
+				return nil, err
+			}
+			virtualForeignKeys = append(virtualForeignKeys, vfkDto)
+		}
+	}
+
+	return &mgmtv1alpha1.UpdateJobSourceConnectionRequest{
+		Id:                 jobId,
+		Source:             source,
+		Mappings:           mappings,
+		VirtualForeignKeys: virtualForeignKeys,
 	}, nil
 }
 
+// Returns true unless the job's mappings have been opted out of, in which case they are managed by neosync_job_mapping resources
+func (j *JobResourceModel) ManagesMappings() bool {
+	return j.ManageMappings.IsNull() || j.ManageMappings.IsUnknown() || j.ManageMappings.ValueBool()
+}
+
+func (j *JobResourceModel) toJobMappingsDto() ([]*mgmtv1alpha1.JobMapping, error) {
+	if !j.ManagesMappings() {
+		return []*mgmtv1alpha1.JobMapping{}, nil
+	}
+	return ToJobMappingsDto(j.Mappings)
+}
+
+// Returns true unless the job's subsets have been opted out of, in which case they are managed by a neosync_job_source_subset resource
+func (j *JobResourceModel) ManagesSubsets() bool {
+	return j.ManageSubsets.IsNull() || j.ManageSubsets.IsUnknown() || j.ManageSubsets.ValueBool()
+}
+
+// Returns a copy of the source without its schemas, tables and subset_by_foreign_key_constraints setting
+func (j *JobSource) withoutSubsets() *JobSource {
+	if j == nil {
+		return nil
+	}
+	source := *j
+	if j.Postgres != nil {
+		postgres := *j.Postgres
+		postgres.SchemaOptions = nil
+		postgres.SubsetByForeignKeyConstraints = types.BoolNull()
+		source.Postgres = &postgres
+	}
+	if j.Mysql != nil {
+		mysql := *j.Mysql
+		mysql.SchemaOptions = nil
+		mysql.SubsetByForeignKeyConstraints = types.BoolNull()
+		source.Mysql = &mysql
+	}
+	if j.Mssql != nil {
+		mssql := *j.Mssql
+		mssql.SchemaOptions = nil
+		mssql.SubsetByForeignKeyConstraints = types.BoolNull()
+		source.Mssql = &mssql
+	}
+	if j.Dynamodb != nil {
+		dynamodb := *j.Dynamodb
+		dynamodb.Tables = nil
+		source.Dynamodb = &dynamodb
+	}
+	return &source
+}
+
+// Carries over the settings that are not stored by the backend from a prior model, such as the plan or the current state.
+func (j *JobResourceModel) MergePrior(prior *JobResourceModel) {
+	if j == nil || prior == nil {
+		return
+	}
+
+	j.rekeyDestinations(prior.Destinations)
+	j.orderMappingsLike(prior.Mappings)
+	j.DeletionProtection = prior.DeletionProtection
+	j.ManageMappings = prior.ManageMappings
+	if !j.ManagesMappings() {
+		j.Mappings = nil
+	}
+	j.ManageSubsets = prior.ManageSubsets
+	if !j.ManagesSubsets() {
+		j.JobSource = j.JobSource.withoutSubsets()
+	}
+}
+
+// Re-keys the destinations using the keys from a prior model, such as the plan or the current state.
+// The backend does not store destination keys, so they must be carried over after every read.
+func (j *JobResourceModel) rekeyDestinations(prior map[string]*JobDestination) {
+	if j == nil || j.Destinations == nil {
+		return
+	}
+	destinations := make([]*JobDestination, 0, len(j.Destinations))
+	for _, key := range sortedDestinationKeys(j.Destinations) {
+		destinations = append(destinations, j.Destinations[key])
+	}
+	j.Destinations = keyJobDestinations(destinations, prior)
+}
+
+// Assigns each destination a key, preferring the key it had in the prior destinations.
+// Destinations are first matched by id, then by connection id to pick up destinations that were just created.
+// Any remaining destinations are keyed by their connection id.
+func keyJobDestinations(destinations []*JobDestination, prior map[string]*JobDestination) map[string]*JobDestination {
+	keyed := make(map[string]*JobDestination, len(destinations))
+	assigned := make([]bool, len(destinations))
+	priorKeys := sortedDestinationKeys(prior)
+
+	for _, key := range priorKeys {
+		priorDst := prior[key]
+		if priorDst == nil || priorDst.Id.ValueString() == "" {
+			continue
+		}
+		for idx, dst := range destinations {
+			if !assigned[idx] && dst.Id.ValueString() == priorDst.Id.ValueString() {
+				keyed[key] = dst
+				assigned[idx] = true
+				break
+			}
+		}
+	}
+
+	for _, key := range priorKeys {
+		priorDst := prior[key]
+		if _, ok := keyed[key]; ok || priorDst == nil {
+			continue
+		}
+		for idx, dst := range destinations {
+			if !assigned[idx] && dst.ConnectionId.ValueString() == priorDst.ConnectionId.ValueString() {
+				keyed[key] = dst
+				assigned[idx] = true
+				break
+			}
+		}
+	}
+
+	for idx, dst := range destinations {
+		if assigned[idx] {
+			continue
+		}
+		keyed[UniqueDestinationKey(keyed, dst.ConnectionId.ValueString())] = dst
+	}
+	return keyed
+}
+
+// Returns the base key, or the base key with a numeric suffix if it is already taken.
+func UniqueDestinationKey[T any](keyed map[string]T, base string) string {
+	key := base
+	for suffix := 2; ; suffix++ {
+		if _, ok := keyed[key]; !ok {
+			return key
+		}
+		key = fmt.Sprintf("%s-%d", base, suffix)
+	}
+}
+
+func sortedDestinationKeys(destinations map[string]*JobDestination) []string {
+	keys := make([]string, 0, len(destinations))
+	for key := range destinations {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Returns true if the destination differs from its previous state
+func (j *JobDestination) hasChanged(previous *JobDestination) (bool, error) {
+	current, err := j.ToDto()
+	if err != nil {
+		return false, err
+	}
+	prev, err := previous.ToDto()
+	if err != nil {
+		return false, err
+	}
+	return !proto.Equal(current, prev), nil
+}
+
 func (j *JobResourceModel) FromDto(dto *mgmtv1alpha1.Job) error {
 	if j == nil {
 		return errors.New("job resource model is nil")
@@ -392,7 +911,7 @@ func (j *JobResourceModel) FromDto(dto *mgmtv1alpha1.Job) error {
 			}
 			destinations = append(destinations, destination)
 		}
-		j.Destinations = destinations
+		j.Destinations = keyJobDestinations(destinations, nil)
 	}
 
 	if dto.SyncOptions != nil && (dto.SyncOptions.ScheduleToCloseTimeout != nil || dto.SyncOptions.StartToCloseTimeout != nil || dto.SyncOptions.RetryPolicy != nil) {
@@ -566,7 +1085,7 @@ func (a *ActivityOptions) FromDto(dto *mgmtv1alpha1.ActivityOptions) error {
 
 	if dto.RetryPolicy != nil {
 		a.RetryPolicy = &RetryPolicy{
-			MaximumAttempts: types.Int64Value(int64(*dto.RetryPolicy.MaximumAttempts)),
+			MaximumAttempts: i32Toi64Value(dto.RetryPolicy.MaximumAttempts),
 		}
 	}
 	return nil
@@ -669,78 +1188,85 @@ func (j *JobDestination) ToCreateJobDestinationDto() (*mgmtv1alpha1.CreateJobDes
 		return nil, errors.New("job destination is nil")
 	}
 
-	jd := &mgmtv1alpha1.CreateJobDestination{
+	options, err := j.toOptionsDto()
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtv1alpha1.CreateJobDestination{
 		ConnectionId: j.ConnectionId.ValueString(),
+		Options:      options,
+	}, nil
+}
+
+func (j *JobDestination) ToDto() (*mgmtv1alpha1.JobDestination, error) {
+	if j == nil {
+		return nil, errors.New("job destination is nil")
+	}
+
+	options, err := j.toOptionsDto()
+	if err != nil {
+		return nil, err
 	}
 
+	return &mgmtv1alpha1.JobDestination{
+		Id:           j.Id.ValueString(),
+		ConnectionId: j.ConnectionId.ValueString(),
+		Options:      options,
+	}, nil
+}
+
+func (j *JobDestination) toOptionsDto() (*mgmtv1alpha1.JobDestinationOptions, error) {
 	if j.Postgres != nil {
 		pgDto, err := j.Postgres.ToDto()
 		if err != nil {
 			return nil, err
 		}
-		jd.Options = &mgmtv1alpha1.JobDestinationOptions{
-			Config: pgDto,
-		}
+		return &mgmtv1alpha1.JobDestinationOptions{Config: pgDto}, nil
 	}
 	if j.Mysql != nil {
 		mysqlDto, err := j.Mysql.ToDto()
 		if err != nil {
 			return nil, err
 		}
-		jd.Options = &mgmtv1alpha1.JobDestinationOptions{
-			Config: mysqlDto,
+		return &mgmtv1alpha1.JobDestinationOptions{Config: mysqlDto}, nil
+	}
+	if j.Mssql != nil {
+		mssqlDto, err := j.Mssql.ToDto()
+		if err != nil {
+			return nil, err
 		}
+		return &mgmtv1alpha1.JobDestinationOptions{Config: mssqlDto}, nil
 	}
 	if j.AwsS3 != nil {
 		awsS3Dto, err := j.AwsS3.ToDto()
 		if err != nil {
 			return nil, err
 		}
-		jd.Options = &mgmtv1alpha1.JobDestinationOptions{
-			Config: awsS3Dto,
-		}
-	}
-	return jd, nil
-}
-
-func (j *JobDestination) ToDto() (*mgmtv1alpha1.JobDestination, error) {
-	if j == nil {
-		return nil, errors.New("job destination is nil")
+		return &mgmtv1alpha1.JobDestinationOptions{Config: awsS3Dto}, nil
 	}
-
-	jd := &mgmtv1alpha1.JobDestination{
-		Id:           j.Id.ValueString(),
-		ConnectionId: j.ConnectionId.ValueString(),
-	}
-
-	if j.Postgres != nil {
-		pgDto, err := j.Postgres.ToDto()
+	if j.Mongodb != nil {
+		mongodbDto, err := j.Mongodb.ToDto()
 		if err != nil {
 			return nil, err
 		}
-		jd.Options = &mgmtv1alpha1.JobDestinationOptions{
-			Config: pgDto,
-		}
+		return &mgmtv1alpha1.JobDestinationOptions{Config: mongodbDto}, nil
 	}
-	if j.Mysql != nil {
-		mysqlDto, err := j.Mysql.ToDto()
+	if j.Dynamodb != nil {
+		dynamodbDto, err := j.Dynamodb.ToDto()
 		if err != nil {
 			return nil, err
 		}
-		jd.Options = &mgmtv1alpha1.JobDestinationOptions{
-			Config: mysqlDto,
-		}
+		return &mgmtv1alpha1.JobDestinationOptions{Config: dynamodbDto}, nil
 	}
-	if j.AwsS3 != nil {
-		awsS3Dto, err := j.AwsS3.ToDto()
+	if j.GcpCloudStorage != nil {
+		gcsDto, err := j.GcpCloudStorage.ToDto()
 		if err != nil {
 			return nil, err
 		}
-		jd.Options = &mgmtv1alpha1.JobDestinationOptions{
-			Config: awsS3Dto,
-		}
+		return &mgmtv1alpha1.JobDestinationOptions{Config: gcsDto}, nil
 	}
-	return jd, nil
+	return nil, nil
 }
 
 func (j *JobDestination) FromDto(dto *mgmtv1alpha1.JobDestination) error {
@@ -767,12 +1293,36 @@ func (j *JobDestination) FromDto(dto *mgmtv1alpha1.JobDestination) error {
 		if err != nil {
 			return err
 		}
-	case *mgmtv1alpha1.JobDestinationOptions_AwsS3Options:
+	case *mgmtv1alpha1.JobDestinationOptions_MssqlOptions:
+		j.Mssql = &JobDestinationMssqlOptions{}
+		err := j.Mssql.FromDto(config.MssqlOptions)
+		if err != nil {
+			return err
+		}
+	case *mgmtv1alpha1.JobDestinationOptions_AwsS3Options:
 		j.AwsS3 = &JobDestinationAwsS3Options{}
 		err := j.AwsS3.FromDto(config.AwsS3Options)
 		if err != nil {
 			return err
 		}
+	case *mgmtv1alpha1.JobDestinationOptions_MongodbOptions:
+		j.Mongodb = &JobDestinationMongodbOptions{}
+		err := j.Mongodb.FromDto(config.MongodbOptions)
+		if err != nil {
+			return err
+		}
+	case *mgmtv1alpha1.JobDestinationOptions_DynamodbOptions:
+		j.Dynamodb = &JobDestinationDynamodbOptions{}
+		err := j.Dynamodb.FromDto(config.DynamodbOptions)
+		if err != nil {
+			return err
+		}
+	case *mgmtv1alpha1.JobDestinationOptions_GcpCloudstorageOptions:
+		j.GcpCloudStorage = &JobDestinationGcpCloudStorageOptions{}
+		err := j.GcpCloudStorage.FromDto(config.GcpCloudstorageOptions)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -790,14 +1340,28 @@ func (j *JobDestinationPostgresOptions) ToDto() (*mgmtv1alpha1.JobDestinationOpt
 		}
 	}
 
+	var onConflict *mgmtv1alpha1.PostgresOnConflictConfig
+	if j.OnConflict != nil {
+		onConflict = &mgmtv1alpha1.PostgresOnConflictConfig{}
+		if j.OnConflict.Nothing != nil {
+			onConflict.Strategy = &mgmtv1alpha1.PostgresOnConflictConfig_Nothing{
+				Nothing: &mgmtv1alpha1.PostgresOnConflictConfig_PostgresOnConflictDoNothing{},
+			}
+		} else if j.OnConflict.Update != nil {
+			onConflict.Strategy = &mgmtv1alpha1.PostgresOnConflictConfig_Update{
+				Update: &mgmtv1alpha1.PostgresOnConflictConfig_PostgresOnConflictUpdate{},
+			}
+		}
+	}
+
 	dto := &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
 		PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
 			TruncateTable:            truncateTable,
 			InitTableSchema:          j.InitTableSchema.ValueBool(),
-			MaxInFlight:              nil,   // todo
-			OnConflict:               nil,   // todo
-			SkipForeignKeyViolations: false, // todo
-			Batch:                    nil,   // todo
+			MaxInFlight:              i64Tou32(j.MaxInFlight.ValueInt64Pointer()),
+			OnConflict:               onConflict,
+			SkipForeignKeyViolations: j.SkipForeignKeyViolations.ValueBool(),
+			Batch:                    j.Batch.ToDto(),
 		},
 	}
 
@@ -820,10 +1384,45 @@ func (j *JobDestinationPostgresOptions) FromDto(dto *mgmtv1alpha1.PostgresDestin
 	}
 
 	j.InitTableSchema = types.BoolValue(dto.InitTableSchema)
+	j.SkipForeignKeyViolations = types.BoolValue(dto.SkipForeignKeyViolations)
+	j.MaxInFlight = u32Toi64Value(dto.MaxInFlight)
+
+	if dto.OnConflict != nil {
+		switch dto.OnConflict.GetStrategy().(type) {
+		case *mgmtv1alpha1.PostgresOnConflictConfig_Nothing:
+			j.OnConflict = &PostgresDestinationOnConflict{Nothing: &PostgresDestinationOnConflictNothing{}}
+		case *mgmtv1alpha1.PostgresOnConflictConfig_Update:
+			j.OnConflict = &PostgresDestinationOnConflict{Update: &PostgresDestinationOnConflictUpdate{}}
+		default:
+			if dto.OnConflict.GetDoNothing() {
+				j.OnConflict = &PostgresDestinationOnConflict{Nothing: &PostgresDestinationOnConflictNothing{}}
+			}
+		}
+	}
+
+	if dto.Batch != nil {
+		j.Batch = &DestinationBatch{}
+		j.Batch.FromDto(dto.Batch)
+	}
 
 	return nil
 }
 
+func (b *DestinationBatch) ToDto() *mgmtv1alpha1.BatchConfig {
+	if b == nil {
+		return nil
+	}
+	return &mgmtv1alpha1.BatchConfig{
+		Count:  i64Tou32(b.Count.ValueInt64Pointer()),
+		Period: b.Period.ValueStringPointer(),
+	}
+}
+
+func (b *DestinationBatch) FromDto(dto *mgmtv1alpha1.BatchConfig) {
+	b.Count = u32Toi64Value(dto.Count)
+	b.Period = models.NewDurationPointerValue(dto.Period)
+}
+
 func (j *JobDestinationMysqlOptions) ToDto() (*mgmtv1alpha1.JobDestinationOptions_MysqlOptions, error) {
 	if j == nil {
 		return nil, errors.New("job destination mysql options is nil")
@@ -836,14 +1435,28 @@ func (j *JobDestinationMysqlOptions) ToDto() (*mgmtv1alpha1.JobDestinationOption
 		}
 	}
 
+	var onConflict *mgmtv1alpha1.MysqlOnConflictConfig
+	if j.OnConflict != nil {
+		onConflict = &mgmtv1alpha1.MysqlOnConflictConfig{}
+		if j.OnConflict.Nothing != nil {
+			onConflict.Strategy = &mgmtv1alpha1.MysqlOnConflictConfig_Nothing{
+				Nothing: &mgmtv1alpha1.MysqlOnConflictConfig_MysqlOnConflictDoNothing{},
+			}
+		} else if j.OnConflict.Update != nil {
+			onConflict.Strategy = &mgmtv1alpha1.MysqlOnConflictConfig_Update{
+				Update: &mgmtv1alpha1.MysqlOnConflictConfig_MysqlOnConflictUpdate{},
+			}
+		}
+	}
+
 	dto := &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{
 		MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{
 			TruncateTable:            truncateTable,
 			InitTableSchema:          j.InitTableSchema.ValueBool(),
-			SkipForeignKeyViolations: false, // todo
-			MaxInFlight:              nil,   // todo
-			OnConflict:               nil,   // todo
-			Batch:                    nil,   // todo
+			SkipForeignKeyViolations: j.SkipForeignKeyViolations.ValueBool(),
+			MaxInFlight:              i64Tou32(j.MaxInFlight.ValueInt64Pointer()),
+			OnConflict:               onConflict,
+			Batch:                    j.Batch.ToDto(),
 		},
 	}
 
@@ -865,6 +1478,27 @@ func (j *JobDestinationMysqlOptions) FromDto(dto *mgmtv1alpha1.MysqlDestinationC
 	}
 
 	j.InitTableSchema = types.BoolValue(dto.InitTableSchema)
+	j.SkipForeignKeyViolations = types.BoolValue(dto.SkipForeignKeyViolations)
+	j.MaxInFlight = u32Toi64Value(dto.MaxInFlight)
+
+	if dto.OnConflict != nil {
+		switch dto.OnConflict.GetStrategy().(type) {
+		case *mgmtv1alpha1.MysqlOnConflictConfig_Nothing:
+			j.OnConflict = &MysqlDestinationOnConflict{Nothing: &MysqlDestinationOnConflictNothing{}}
+		case *mgmtv1alpha1.MysqlOnConflictConfig_Update:
+			j.OnConflict = &MysqlDestinationOnConflict{Update: &MysqlDestinationOnConflictUpdate{}}
+		default:
+			if dto.OnConflict.GetDoNothing() {
+				j.OnConflict = &MysqlDestinationOnConflict{Nothing: &MysqlDestinationOnConflictNothing{}}
+			}
+		}
+	}
+
+	if dto.Batch != nil {
+		j.Batch = &DestinationBatch{}
+		j.Batch.FromDto(dto.Batch)
+	}
+
 	return nil
 }
 
@@ -875,7 +1509,10 @@ func (j *JobDestinationAwsS3Options) ToDto() (*mgmtv1alpha1.JobDestinationOption
 
 	dto := &mgmtv1alpha1.JobDestinationOptions_AwsS3Options{
 		AwsS3Options: &mgmtv1alpha1.AwsS3DestinationConnectionOptions{
-			// todo
+			StorageClass: ToAwsS3StorageClassDto(j.StorageClass.ValueString()),
+			MaxInFlight:  i64Tou32(j.MaxInFlight.ValueInt64Pointer()),
+			Timeout:      j.Timeout.ValueStringPointer(),
+			Batch:        j.Batch.ToDto(),
 		},
 	}
 
@@ -890,8 +1527,189 @@ func (j *JobDestinationAwsS3Options) FromDto(dto *mgmtv1alpha1.AwsS3DestinationC
 		return errors.New("job destination aws s3 options dto is nil")
 	}
 
-	// todo
+	j.StorageClass = FromAwsS3StorageClassDto(dto.StorageClass)
+	j.MaxInFlight = u32Toi64Value(dto.MaxInFlight)
+	j.Timeout = models.NewDurationPointerValue(dto.Timeout)
+	if dto.Batch != nil {
+		j.Batch = &DestinationBatch{}
+		j.Batch.FromDto(dto.Batch)
+	}
+
+	return nil
+}
+
+const awsS3StorageClassPrefix = "STORAGE_CLASS_"
+
+// The storage classes that may be configured on an AWS S3 destination
+var AwsS3StorageClasses = []string{
+	"standard",
+	"reduced_redundancy",
+	"glacier",
+	"standard_ia",
+	"onezone_ia",
+	"intelligent_tiering",
+	"deep_archive",
+}
+
+func ToAwsS3StorageClassDto(storageClass string) mgmtv1alpha1.AwsS3DestinationConnectionOptions_StorageClass {
+	value, ok := mgmtv1alpha1.AwsS3DestinationConnectionOptions_StorageClass_value[awsS3StorageClassPrefix+strings.ToUpper(storageClass)]
+	if !ok {
+		return mgmtv1alpha1.AwsS3DestinationConnectionOptions_STORAGE_CLASS_UNSPECIFIED
+	}
+	return mgmtv1alpha1.AwsS3DestinationConnectionOptions_StorageClass(value)
+}
+
+func FromAwsS3StorageClassDto(storageClass mgmtv1alpha1.AwsS3DestinationConnectionOptions_StorageClass) types.String {
+	if storageClass == mgmtv1alpha1.AwsS3DestinationConnectionOptions_STORAGE_CLASS_UNSPECIFIED {
+		return types.StringNull()
+	}
+	name, ok := mgmtv1alpha1.AwsS3DestinationConnectionOptions_StorageClass_name[int32(storageClass)]
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(strings.ToLower(strings.TrimPrefix(name, awsS3StorageClassPrefix)))
+}
+
+func (j *JobDestinationMssqlOptions) ToDto() (*mgmtv1alpha1.JobDestinationOptions_MssqlOptions, error) {
+	if j == nil {
+		return nil, errors.New("job destination mssql options is nil")
+	}
+
+	var truncateTable *mgmtv1alpha1.MssqlTruncateTableConfig
+	if j.TruncateTable != nil {
+		truncateTable = &mgmtv1alpha1.MssqlTruncateTableConfig{
+			TruncateBeforeInsert: j.TruncateTable.TruncateBeforeInsert.ValueBool(),
+		}
+	}
+
+	var onConflict *mgmtv1alpha1.MssqlOnConflictConfig
+	if j.OnConflict != nil {
+		onConflict = &mgmtv1alpha1.MssqlOnConflictConfig{
+			DoNothing: j.OnConflict.Nothing != nil,
+		}
+	}
+
+	dto := &mgmtv1alpha1.JobDestinationOptions_MssqlOptions{
+		MssqlOptions: &mgmtv1alpha1.MssqlDestinationConnectionOptions{
+			TruncateTable:            truncateTable,
+			InitTableSchema:          j.InitTableSchema.ValueBool(),
+			OnConflict:               onConflict,
+			SkipForeignKeyViolations: j.SkipForeignKeyViolations.ValueBool(),
+			MaxInFlight:              i64Tou32(j.MaxInFlight.ValueInt64Pointer()),
+			Batch:                    j.Batch.ToDto(),
+		},
+	}
+
+	return dto, nil
+}
+
+func (j *JobDestinationMssqlOptions) FromDto(dto *mgmtv1alpha1.MssqlDestinationConnectionOptions) error {
+	if j == nil {
+		return errors.New("job destination mssql options is nil")
+	}
+	if dto == nil {
+		return errors.New("job destination mssql options dto is nil")
+	}
+
+	if dto.TruncateTable != nil {
+		j.TruncateTable = &MssqlDestinationTruncateTable{
+			TruncateBeforeInsert: types.BoolValue(dto.TruncateTable.TruncateBeforeInsert),
+		}
+	}
+
+	j.InitTableSchema = types.BoolValue(dto.InitTableSchema)
+	j.SkipForeignKeyViolations = types.BoolValue(dto.SkipForeignKeyViolations)
+	j.MaxInFlight = u32Toi64Value(dto.MaxInFlight)
+
+	if dto.OnConflict.GetDoNothing() {
+		j.OnConflict = &MssqlDestinationOnConflict{Nothing: &MssqlDestinationOnConflictNothing{}}
+	}
+
+	if dto.Batch != nil {
+		j.Batch = &DestinationBatch{}
+		j.Batch.FromDto(dto.Batch)
+	}
+
+	return nil
+}
+
+func (j *JobDestinationMongodbOptions) ToDto() (*mgmtv1alpha1.JobDestinationOptions_MongodbOptions, error) {
+	if j == nil {
+		return nil, errors.New("job destination mongodb options is nil")
+	}
+
+	return &mgmtv1alpha1.JobDestinationOptions_MongodbOptions{
+		MongodbOptions: &mgmtv1alpha1.MongoDBDestinationConnectionOptions{},
+	}, nil
+}
+
+func (j *JobDestinationMongodbOptions) FromDto(dto *mgmtv1alpha1.MongoDBDestinationConnectionOptions) error {
+	if j == nil {
+		return errors.New("job destination mongodb options is nil")
+	}
+	if dto == nil {
+		return errors.New("job destination mongodb options dto is nil")
+	}
+	return nil
+}
+
+func (j *JobDestinationDynamodbOptions) ToDto() (*mgmtv1alpha1.JobDestinationOptions_DynamodbOptions, error) {
+	if j == nil {
+		return nil, errors.New("job destination dynamodb options is nil")
+	}
+
+	tableMappings := make([]*mgmtv1alpha1.DynamoDBDestinationTableMapping, 0, len(j.TableMappings))
+	for _, mapping := range j.TableMappings {
+		tableMappings = append(tableMappings, &mgmtv1alpha1.DynamoDBDestinationTableMapping{
+			SourceTable:      mapping.SourceTable.ValueString(),
+			DestinationTable: mapping.DestinationTable.ValueString(),
+		})
+	}
+
+	return &mgmtv1alpha1.JobDestinationOptions_DynamodbOptions{
+		DynamodbOptions: &mgmtv1alpha1.DynamoDBDestinationConnectionOptions{
+			TableMappings: tableMappings,
+		},
+	}, nil
+}
+
+func (j *JobDestinationDynamodbOptions) FromDto(dto *mgmtv1alpha1.DynamoDBDestinationConnectionOptions) error {
+	if j == nil {
+		return errors.New("job destination dynamodb options is nil")
+	}
+	if dto == nil {
+		return errors.New("job destination dynamodb options dto is nil")
+	}
+
+	tableMappings := make([]*DynamodbDestinationTableMapping, 0, len(dto.TableMappings))
+	for _, mapping := range dto.TableMappings {
+		tableMappings = append(tableMappings, &DynamodbDestinationTableMapping{
+			SourceTable:      types.StringValue(mapping.SourceTable),
+			DestinationTable: types.StringValue(mapping.DestinationTable),
+		})
+	}
+	j.TableMappings = tableMappings
+
+	return nil
+}
+
+func (j *JobDestinationGcpCloudStorageOptions) ToDto() (*mgmtv1alpha1.JobDestinationOptions_GcpCloudstorageOptions, error) {
+	if j == nil {
+		return nil, errors.New("job destination gcp cloud storage options is nil")
+	}
+
+	return &mgmtv1alpha1.JobDestinationOptions_GcpCloudstorageOptions{
+		GcpCloudstorageOptions: &mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions{},
+	}, nil
+}
 
+func (j *JobDestinationGcpCloudStorageOptions) FromDto(dto *mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions) error {
+	if j == nil {
+		return errors.New("job destination gcp cloud storage options is nil")
+	}
+	if dto == nil {
+		return errors.New("job destination gcp cloud storage options dto is nil")
+	}
 	return nil
 }
 
@@ -944,6 +1762,50 @@ func (j *JobSource) ToDto() (*mgmtv1alpha1.JobSource, error) {
 			},
 		}, nil
 	}
+	if j.Mssql != nil {
+		mssqlDto, err := j.Mssql.ToDto()
+		if err != nil {
+			return nil, err
+		}
+		return &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: mssqlDto,
+			},
+		}, nil
+	}
+	if j.Mongodb != nil {
+		mongodbDto, err := j.Mongodb.ToDto()
+		if err != nil {
+			return nil, err
+		}
+		return &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: mongodbDto,
+			},
+		}, nil
+	}
+	if j.Dynamodb != nil {
+		dynamodbDto, err := j.Dynamodb.ToDto()
+		if err != nil {
+			return nil, err
+		}
+		return &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: dynamodbDto,
+			},
+		}, nil
+	}
+	if j.AiGenerate != nil {
+		aiGenerateDto, err := j.AiGenerate.ToDto()
+		if err != nil {
+			return nil, err
+		}
+		return &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: aiGenerateDto,
+			},
+		}, nil
+	}
 
 	return nil, nil
 }
@@ -1222,6 +2084,30 @@ func (j *JobSource) FromDto(dto *mgmtv1alpha1.JobSource) error {
 		if err != nil {
 			return err
 		}
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		j.Mssql = &JobSourceMssqlOptions{}
+		err := j.Mssql.FromDto(source.Mssql)
+		if err != nil {
+			return err
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Mongodb:
+		j.Mongodb = &JobSourceMongodbOptions{}
+		err := j.Mongodb.FromDto(source.Mongodb)
+		if err != nil {
+			return err
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Dynamodb:
+		j.Dynamodb = &JobSourceDynamodbOptions{}
+		err := j.Dynamodb.FromDto(source.Dynamodb)
+		if err != nil {
+			return err
+		}
+	case *mgmtv1alpha1.JobSourceOptions_AiGenerate:
+		j.AiGenerate = &JobSourceAiGenerateOptions{}
+		err := j.AiGenerate.FromDto(source.AiGenerate)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1416,6 +2302,131 @@ func (j *JobSourceGenerateTableOption) FromDto(dto *mgmtv1alpha1.GenerateSourceT
 	return nil
 }
 
+func (j *JobSourceAiGenerateOptions) ToDto() (*mgmtv1alpha1.JobSourceOptions_AiGenerate, error) {
+	if j == nil {
+		return nil, errors.New("job source ai generate options is nil")
+	}
+
+	var schemas []*mgmtv1alpha1.AiGenerateSourceSchemaOption
+	if len(j.Schemas) > 0 {
+		schemas = make([]*mgmtv1alpha1.AiGenerateSourceSchemaOption, 0, len(j.Schemas))
+		for _, schema := range j.Schemas {
+			schemaDto, err := schema.ToDto()
+			if err != nil {
+				return nil, err
+			}
+			schemas = append(schemas, schemaDto)
+		}
+	}
+
+	return &mgmtv1alpha1.JobSourceOptions_AiGenerate{
+		AiGenerate: &mgmtv1alpha1.AiGenerateSourceOptions{
+			AiConnectionId:       j.AiConnectionId.ValueString(),
+			Schemas:              schemas,
+			FkSourceConnectionId: j.FkSourceConnectionId.ValueStringPointer(),
+			ModelName:            j.ModelName.ValueString(),
+			UserPrompt:           j.UserPrompt.ValueStringPointer(),
+			GenerateBatchSize:    j.GenerateBatchSize.ValueInt64Pointer(),
+		},
+	}, nil
+}
+
+func (j *JobSourceAiGenerateOptions) FromDto(dto *mgmtv1alpha1.AiGenerateSourceOptions) error {
+	if j == nil {
+		return errors.New("job source ai generate options is nil")
+	}
+	if dto == nil {
+		return errors.New("job source ai generate options dto is nil")
+	}
+
+	j.AiConnectionId = types.StringValue(dto.AiConnectionId)
+	j.FkSourceConnectionId = types.StringPointerValue(dto.FkSourceConnectionId)
+	j.ModelName = types.StringValue(dto.ModelName)
+	j.UserPrompt = types.StringPointerValue(dto.UserPrompt)
+	j.GenerateBatchSize = types.Int64PointerValue(dto.GenerateBatchSize)
+
+	j.Schemas = make([]*JobSourceAiGenerateSchemaOption, 0, len(dto.Schemas))
+	for _, schemaDto := range dto.Schemas {
+		schema := &JobSourceAiGenerateSchemaOption{}
+		err := schema.FromDto(schemaDto)
+		if err != nil {
+			return err
+		}
+		j.Schemas = append(j.Schemas, schema)
+	}
+
+	return nil
+}
+
+func (j *JobSourceAiGenerateSchemaOption) ToDto() (*mgmtv1alpha1.AiGenerateSourceSchemaOption, error) {
+	if j == nil {
+		return nil, errors.New("job source ai generate schema option is nil")
+	}
+
+	var tables []*mgmtv1alpha1.AiGenerateSourceTableOption
+	if len(j.Tables) > 0 {
+		tables = make([]*mgmtv1alpha1.AiGenerateSourceTableOption, 0, len(j.Tables))
+		for _, table := range j.Tables {
+			tableDto, err := table.ToDto()
+			if err != nil {
+				return nil, err
+			}
+			tables = append(tables, tableDto)
+		}
+	}
+
+	return &mgmtv1alpha1.AiGenerateSourceSchemaOption{
+		Schema: j.Schema.ValueString(),
+		Tables: tables,
+	}, nil
+}
+
+func (j *JobSourceAiGenerateSchemaOption) FromDto(dto *mgmtv1alpha1.AiGenerateSourceSchemaOption) error {
+	if j == nil {
+		return errors.New("job source ai generate schema option is nil")
+	}
+	if dto == nil {
+		return errors.New("job source ai generate schema option dto is nil")
+	}
+
+	j.Schema = types.StringValue(dto.Schema)
+	j.Tables = make([]*JobSourceAiGenerateTableOption, 0, len(dto.Tables))
+	for _, dtoTable := range dto.Tables {
+		table := &JobSourceAiGenerateTableOption{}
+		err := table.FromDto(dtoTable)
+		if err != nil {
+			return err
+		}
+		j.Tables = append(j.Tables, table)
+	}
+	return nil
+}
+
+func (j *JobSourceAiGenerateTableOption) ToDto() (*mgmtv1alpha1.AiGenerateSourceTableOption, error) {
+	if j == nil {
+		return nil, errors.New("job source ai generate table option is nil")
+	}
+
+	return &mgmtv1alpha1.AiGenerateSourceTableOption{
+		Table:    j.Table.ValueString(),
+		RowCount: j.RowCount.ValueInt64(),
+	}, nil
+}
+
+func (j *JobSourceAiGenerateTableOption) FromDto(dto *mgmtv1alpha1.AiGenerateSourceTableOption) error {
+	if j == nil {
+		return errors.New("job source ai generate table option is nil")
+	}
+	if dto == nil {
+		return errors.New("job source ai generate table option dto is nil")
+	}
+
+	j.Table = types.StringValue(dto.Table)
+	j.RowCount = types.Int64Value(dto.RowCount)
+
+	return nil
+}
+
 func (j *JobSourceAwsS3Options) ToDto() (*mgmtv1alpha1.JobSourceOptions_AwsS3, error) {
 	if j == nil {
 		return nil, errors.New("job source aws s3 options is nil")
@@ -1463,6 +2474,7 @@ func (j *JobSourceMysqlOptions) ToDto() (*mgmtv1alpha1.JobSourceOptions_Mysql, e
 			ConnectionId:                  j.ConnectionId.ValueString(),
 			Schemas:                       schemas,
 			SubsetByForeignKeyConstraints: j.SubsetByForeignKeyConstraints.ValueBool(),
+			HaltOnNewColumnAddition:       j.NewColumnAdditionStrategy != nil && j.NewColumnAdditionStrategy.HaltJob != nil,
 			ColumnRemovalStrategy:         nil, // set below
 		},
 	}
@@ -1500,6 +2512,86 @@ func (j *JobSourceMysqlOptions) FromDto(dto *mgmtv1alpha1.MysqlSourceConnectionO
 	}
 	j.SubsetByForeignKeyConstraints = types.BoolValue(dto.SubsetByForeignKeyConstraints)
 
+	if dto.HaltOnNewColumnAddition {
+		j.NewColumnAdditionStrategy = &MysqlNewColumnAdditionStrategy{HaltJob: &MysqlNewColumnAdditionStrategyHaltJob{}}
+	}
+
+	if dto.ColumnRemovalStrategy != nil {
+		strategy := &MysqlColumnRemovalStrategy{}
+		err := strategy.FromDto(dto.ColumnRemovalStrategy)
+		if err != nil {
+			return err
+		}
+		j.ColumnRemovalStrategy = strategy
+	}
+
+	return nil
+}
+
+func (j *JobSourceMssqlOptions) ToDto() (*mgmtv1alpha1.JobSourceOptions_Mssql, error) {
+	if j == nil {
+		return nil, errors.New("job source mssql options is nil")
+	}
+
+	var schemas []*mgmtv1alpha1.MssqlSourceSchemaOption
+	if len(j.SchemaOptions) > 0 {
+		schemas = make([]*mgmtv1alpha1.MssqlSourceSchemaOption, 0, len(j.SchemaOptions))
+		for _, schema := range j.SchemaOptions {
+			schemaDto, err := schema.ToDto()
+			if err != nil {
+				return nil, err
+			}
+			schemas = append(schemas, schemaDto)
+		}
+	}
+
+	dto := &mgmtv1alpha1.JobSourceOptions_Mssql{
+		Mssql: &mgmtv1alpha1.MssqlSourceConnectionOptions{
+			ConnectionId:                  j.ConnectionId.ValueString(),
+			Schemas:                       schemas,
+			SubsetByForeignKeyConstraints: j.SubsetByForeignKeyConstraints.ValueBool(),
+			HaltOnNewColumnAddition:       j.NewColumnAdditionStrategy != nil && j.NewColumnAdditionStrategy.HaltJob != nil,
+			ColumnRemovalStrategy:         nil, // set below
+		},
+	}
+
+	if j.ColumnRemovalStrategy != nil {
+		strategyDto, err := j.ColumnRemovalStrategy.ToDto()
+		if err != nil {
+			return nil, err
+		}
+		dto.Mssql.ColumnRemovalStrategy = strategyDto
+	}
+
+	return dto, nil
+}
+
+func (j *JobSourceMssqlOptions) FromDto(dto *mgmtv1alpha1.MssqlSourceConnectionOptions) error {
+	if j == nil {
+		return errors.New("job source mssql options is nil")
+	}
+	if dto == nil {
+		return errors.New("job source mssql options dto is nil")
+	}
+
+	j.ConnectionId = types.StringValue(dto.ConnectionId)
+	if len(dto.Schemas) > 0 {
+		j.SchemaOptions = make([]*JobSourceMssqlSourceSchemaOption, 0, len(dto.Schemas))
+		for _, schemaDto := range dto.Schemas {
+			schema := &JobSourceMssqlSourceSchemaOption{}
+			err := schema.FromDto(schemaDto)
+			if err != nil {
+				return err
+			}
+			j.SchemaOptions = append(j.SchemaOptions, schema)
+		}
+	}
+	j.SubsetByForeignKeyConstraints = types.BoolValue(dto.SubsetByForeignKeyConstraints)
+
+	if dto.HaltOnNewColumnAddition {
+		j.NewColumnAdditionStrategy = &MssqlNewColumnAdditionStrategy{HaltJob: &MssqlNewColumnAdditionStrategyHaltJob{}}
+	}
+
 	if dto.ColumnRemovalStrategy != nil {
 		strategy := &MssqlColumnRemovalStrategy{}
 		err := strategy.FromDto(dto.ColumnRemovalStrategy)
@@ -1512,7 +2604,241 @@ func (j *JobSourceMysqlOptions) FromDto(dto *mgmtv1alpha1.MysqlSourceConnectionO
 	return nil
 }
 
-func (j *MssqlColumnRemovalStrategy) ToDto() (*mgmtv1alpha1.MysqlSourceConnectionOptions_ColumnRemovalStrategy, error) {
+func (j *MssqlColumnRemovalStrategy) ToDto() (*mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy, error) {
+	if j == nil {
+		return nil, errors.New("mssql column removal strategy is nil")
+	}
+	if j.HaltJob != nil {
+		return &mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy{
+			Strategy: &mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy_HaltJob_{
+				HaltJob: &mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy_HaltJob{},
+			},
+		}, nil
+	}
+	if j.ContinueJob != nil {
+		return &mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy{
+			Strategy: &mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy_ContinueJob_{
+				ContinueJob: &mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy_ContinueJob{},
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
+func (j *MssqlColumnRemovalStrategy) FromDto(dto *mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy) error {
+	if j == nil {
+		return errors.New("mssql column removal strategy is nil")
+	}
+	if dto == nil {
+		return errors.New("mssql column removal strategy dto is nil")
+	}
+
+	switch dto.GetStrategy().(type) {
+	case *mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy_HaltJob_:
+		j.HaltJob = &MssqlHaltJobColumnRemovalStrategy{}
+	case *mgmtv1alpha1.MssqlSourceConnectionOptions_ColumnRemovalStrategy_ContinueJob_:
+		j.ContinueJob = &MssqlContinueJobColumnRemovalStrategy{}
+	}
+
+	return nil
+}
+
+func (j *JobSourceMssqlSourceSchemaOption) ToDto() (*mgmtv1alpha1.MssqlSourceSchemaOption, error) {
+	if j == nil {
+		return nil, errors.New("job source mssql source schema option is nil")
+	}
+
+	dto := &mgmtv1alpha1.MssqlSourceSchemaOption{
+		Schema: j.Schema.ValueString(),
+		Tables: make([]*mgmtv1alpha1.MssqlSourceTableOption, 0, len(j.Tables)),
+	}
+
+	for _, table := range j.Tables {
+		dto.Tables = append(dto.Tables, &mgmtv1alpha1.MssqlSourceTableOption{
+			Table:       table.Table.ValueString(),
+			WhereClause: table.WhereClause.ValueStringPointer(),
+		})
+	}
+	return dto, nil
+}
+
+func (j *JobSourceMssqlSourceSchemaOption) FromDto(dto *mgmtv1alpha1.MssqlSourceSchemaOption) error {
+	if j == nil {
+		return errors.New("job source mssql source schema option is nil")
+	}
+	if dto == nil {
+		return errors.New("job source mssql source schema option dto is nil")
+	}
+
+	j.Schema = types.StringValue(dto.Schema)
+	j.Tables = make([]*JobSourceMssqlSourceTableOption, 0, len(dto.Tables))
+	for _, dtoTable := range dto.Tables {
+		j.Tables = append(j.Tables, &JobSourceMssqlSourceTableOption{
+			Table:       types.StringValue(dtoTable.Table),
+			WhereClause: types.StringPointerValue(dtoTable.WhereClause),
+		})
+	}
+
+	return nil
+}
+
+func (j *JobSourceMongodbOptions) ToDto() (*mgmtv1alpha1.JobSourceOptions_Mongodb, error) {
+	if j == nil {
+		return nil, errors.New("job source mongodb options is nil")
+	}
+
+	return &mgmtv1alpha1.JobSourceOptions_Mongodb{
+		Mongodb: &mgmtv1alpha1.MongoDBSourceConnectionOptions{
+			ConnectionId: j.ConnectionId.ValueString(),
+		},
+	}, nil
+}
+
+func (j *JobSourceMongodbOptions) FromDto(dto *mgmtv1alpha1.MongoDBSourceConnectionOptions) error {
+	if j == nil {
+		return errors.New("job source mongodb options is nil")
+	}
+	if dto == nil {
+		return errors.New("job source mongodb options dto is nil")
+	}
+
+	j.ConnectionId = types.StringValue(dto.ConnectionId)
+
+	return nil
+}
+
+func (j *JobSourceDynamodbOptions) ToDto() (*mgmtv1alpha1.JobSourceOptions_Dynamodb, error) {
+	if j == nil {
+		return nil, errors.New("job source dynamodb options is nil")
+	}
+
+	tables := make([]*mgmtv1alpha1.DynamoDBSourceTableOption, 0, len(j.Tables))
+	for _, table := range j.Tables {
+		tables = append(tables, &mgmtv1alpha1.DynamoDBSourceTableOption{
+			Table:       table.Table.ValueString(),
+			WhereClause: table.WhereClause.ValueStringPointer(),
+		})
+	}
+
+	dto := &mgmtv1alpha1.JobSourceOptions_Dynamodb{
+		Dynamodb: &mgmtv1alpha1.DynamoDBSourceConnectionOptions{
+			ConnectionId:         j.ConnectionId.ValueString(),
+			Tables:               tables,
+			EnableConsistentRead: j.EnableConsistentRead.ValueBool(),
+		},
+	}
+
+	if j.UnmappedTransforms != nil {
+		unmappedDto, err := j.UnmappedTransforms.ToDto()
+		if err != nil {
+			return nil, err
+		}
+		dto.Dynamodb.UnmappedTransforms = unmappedDto
+	}
+
+	return dto, nil
+}
+
+func (j *JobSourceDynamodbOptions) FromDto(dto *mgmtv1alpha1.DynamoDBSourceConnectionOptions) error {
+	if j == nil {
+		return errors.New("job source dynamodb options is nil")
+	}
+	if dto == nil {
+		return errors.New("job source dynamodb options dto is nil")
+	}
+
+	j.ConnectionId = types.StringValue(dto.ConnectionId)
+	j.EnableConsistentRead = types.BoolValue(dto.EnableConsistentRead)
+	if len(dto.Tables) > 0 {
+		j.Tables = make([]*JobSourceDynamodbTableOption, 0, len(dto.Tables))
+		for _, table := range dto.Tables {
+			j.Tables = append(j.Tables, &JobSourceDynamodbTableOption{
+				Table:       types.StringValue(table.Table),
+				WhereClause: types.StringPointerValue(table.WhereClause),
+			})
+		}
+	}
+
+	if dto.UnmappedTransforms != nil {
+		j.UnmappedTransforms = &DynamodbUnmappedTransformConfig{}
+		err := j.UnmappedTransforms.FromDto(dto.UnmappedTransforms)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (j *DynamodbUnmappedTransformConfig) ToDto() (*mgmtv1alpha1.DynamoDBSourceUnmappedTransformConfig, error) {
+	if j == nil {
+		return nil, errors.New("dynamodb unmapped transform config is nil")
+	}
+
+	dto := &mgmtv1alpha1.DynamoDBSourceUnmappedTransformConfig{}
+	var err error
+	if dto.B, err = toJobMappingTransformerDto(j.B); err != nil {
+		return nil, err
+	}
+	if dto.Boolean, err = toJobMappingTransformerDto(j.Boolean); err != nil {
+		return nil, err
+	}
+	if dto.N, err = toJobMappingTransformerDto(j.N); err != nil {
+		return nil, err
+	}
+	if dto.S, err = toJobMappingTransformerDto(j.S); err != nil {
+		return nil, err
+	}
+	return dto, nil
+}
+
+func (j *DynamodbUnmappedTransformConfig) FromDto(dto *mgmtv1alpha1.DynamoDBSourceUnmappedTransformConfig) error {
+	if j == nil {
+		return errors.New("dynamodb unmapped transform config is nil")
+	}
+	if dto == nil {
+		return errors.New("dynamodb unmapped transform config dto is nil")
+	}
+
+	var err error
+	if j.B, err = fromJobMappingTransformerDto(dto.B); err != nil {
+		return err
+	}
+	if j.Boolean, err = fromJobMappingTransformerDto(dto.Boolean); err != nil {
+		return err
+	}
+	if j.N, err = fromJobMappingTransformerDto(dto.N); err != nil {
+		return err
+	}
+	if j.S, err = fromJobMappingTransformerDto(dto.S); err != nil {
+		return err
+	}
+	return nil
+}
+
+func toJobMappingTransformerDto(transformer *transformer_model.Transformer) (*mgmtv1alpha1.JobMappingTransformer, error) {
+	if transformer == nil {
+		return nil, nil
+	}
+	config, err := transformer.ToDto()
+	if err != nil {
+		return nil, err
+	}
+	return &mgmtv1alpha1.JobMappingTransformer{Config: config}, nil
+}
+
+func fromJobMappingTransformerDto(dto *mgmtv1alpha1.JobMappingTransformer) (*transformer_model.Transformer, error) {
+	if dto == nil {
+		return nil, nil
+	}
+	transformer := &transformer_model.Transformer{}
+	if err := transformer.FromDto(dto.GetConfig()); err != nil {
+		return nil, err
+	}
+	return transformer, nil
+}
+
+func (j *MysqlColumnRemovalStrategy) ToDto() (*mgmtv1alpha1.MysqlSourceConnectionOptions_ColumnRemovalStrategy, error) {
 	if j == nil {
 		return nil, errors.New("mysql column removal strategy is nil")
 	}
@@ -1533,7 +2859,7 @@ func (j *MssqlColumnRemovalStrategy) ToDto() (*mgmtv1alpha1.MysqlSourceConnectio
 	return nil, nil
 }
 
-func (j *MssqlColumnRemovalStrategy) FromDto(dto *mgmtv1alpha1.MysqlSourceConnectionOptions_ColumnRemovalStrategy) error {
+func (j *MysqlColumnRemovalStrategy) FromDto(dto *mgmtv1alpha1.MysqlSourceConnectionOptions_ColumnRemovalStrategy) error {
 	if j == nil {
 		return errors.New("mysql column removal strategy is nil")
 	}
@@ -1543,14 +2869,34 @@ func (j *MssqlColumnRemovalStrategy) FromDto(dto *mgmtv1alpha1.MysqlSourceConnec
 
 	switch dto.GetStrategy().(type) {
 	case *mgmtv1alpha1.MysqlSourceConnectionOptions_ColumnRemovalStrategy_HaltJob_:
-		j.HaltJob = &MssqlHaltJobColumnRemovalStrategy{}
+		j.HaltJob = &MysqlHaltJobColumnRemovalStrategy{}
 	case *mgmtv1alpha1.MysqlSourceConnectionOptions_ColumnRemovalStrategy_ContinueJob_:
-		j.ContinueJob = &MssqlContinueJobColumnRemovalStrategy{}
+		j.ContinueJob = &MysqlContinueJobColumnRemovalStrategy{}
 	}
 
 	return nil
 }
 
+// if input is unsafe, returns nil.
+func i64Tou32(input *int64) *uint32 {
+	if input == nil {
+		return nil
+	}
+
+	if *input < 0 || *input > math.MaxUint32 {
+		return nil
+	}
+	output := uint32(*input)
+	return &output
+}
+
+func u32Toi64Value(input *uint32) types.Int64 {
+	if input == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*input))
+}
+
 // if input is unsafe, returns nil.
 func i64Toi32(input *int64) *int32 {
 	if input == nil {
@@ -1563,3 +2909,10 @@ func i64Toi32(input *int64) *int32 {
 	output := int32(*input)
 	return &output
 }
+
+func i32Toi64Value(input *int32) types.Int64 {
+	if input == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*input))
+}
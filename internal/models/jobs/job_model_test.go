@@ -0,0 +1,281 @@
+package job_model
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	transformer_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/transformers"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JobResourceModel_rekeyDestinations(t *testing.T) {
+	model := &JobResourceModel{
+		Destinations: keyJobDestinations([]*JobDestination{
+			newTestJobDestination("dst-1", "conn-1"),
+			newTestJobDestination("dst-2", "conn-2"),
+			newTestJobDestination("dst-3", "conn-2"),
+			newTestJobDestination("dst-4", "conn-4"),
+		}, nil),
+	}
+	require.ElementsMatch(t, []string{"conn-1", "conn-2", "conn-2-2", "conn-4"}, sortedDestinationKeys(model.Destinations))
+
+	model.rekeyDestinations(map[string]*JobDestination{
+		// matched by id
+		"replica": newTestJobDestination("dst-2", "conn-2"),
+		"primary": newTestJobDestination("dst-1", "conn-1"),
+		// just created, so matched by connection id
+		"backup": {Id: types.StringUnknown(), ConnectionId: types.StringValue("conn-2")},
+	})
+
+	require.Len(t, model.Destinations, 4)
+	require.Equal(t, "dst-1", model.Destinations["primary"].Id.ValueString())
+	require.Equal(t, "dst-2", model.Destinations["replica"].Id.ValueString())
+	require.Equal(t, "dst-3", model.Destinations["backup"].Id.ValueString())
+	require.Equal(t, "dst-4", model.Destinations["conn-4"].Id.ValueString())
+}
+
+func Test_JobResourceModel_orderMappingsLike(t *testing.T) {
+	model := &JobResourceModel{
+		Mappings: []*JobMapping{
+			newTestJobMapping("public", "users", "name"),
+			newTestJobMapping("public", "orders", "id"),
+			newTestJobMapping("public", "users", "id"),
+			newTestJobMapping("public", "users", "email"),
+			newTestJobMapping("a", "b.c", "id"),
+			newTestJobMapping("a.b", "c", "id"),
+		},
+	}
+
+	model.orderMappingsLike([]*JobMapping{
+		newTestJobMapping("public", "users", "id"),
+		newTestJobMapping("a.b", "c", "id"),
+		newTestJobMapping("public", "users", "email"),
+		newTestJobMapping("a", "b.c", "id"),
+		newTestJobMapping("public", "users", "name"),
+	})
+
+	keys := make([]JobMappingKey, 0, len(model.Mappings))
+	for _, mapping := range model.Mappings {
+		keys = append(keys, mapping.key())
+	}
+	require.Equal(t, []JobMappingKey{
+		{Schema: "public", Table: "users", Column: "id"},
+		{Schema: "a.b", Table: "c", Column: "id"},
+		{Schema: "public", Table: "users", Column: "email"},
+		{Schema: "a", Table: "b.c", Column: "id"},
+		{Schema: "public", Table: "users", Column: "name"},
+		{Schema: "public", Table: "orders", Column: "id"},
+	}, keys)
+}
+
+func Test_JobResourceModel_ToUpdateJobDto_JobOptions(t *testing.T) {
+	state := newTestUpdateJobModel()
+	state.SyncOptions = &ActivityOptions{
+		ScheduleToCloseTimeout: types.Int64Null(),
+		StartToCloseTimeout:    types.Int64Value(60),
+		RetryPolicy:            &RetryPolicy{MaximumAttempts: types.Int64Value(2)},
+	}
+
+	plan := newTestUpdateJobModel()
+	plan.SyncOptions = &ActivityOptions{
+		ScheduleToCloseTimeout: types.Int64Null(),
+		StartToCloseTimeout:    types.Int64Value(60),
+		RetryPolicy:            &RetryPolicy{MaximumAttempts: types.Int64Value(2)},
+	}
+	updateJobRequest, err := state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.Nil(t, updateJobRequest.SetJobSyncOptionsRequest)
+	require.Nil(t, updateJobRequest.SetJobWorkflowOptionsRequest)
+
+	plan.SyncOptions.RetryPolicy.MaximumAttempts = types.Int64Value(5)
+	plan.WorkflowOptions = &WorkflowOptions{RunTimeout: types.Int64Value(3600)}
+	updateJobRequest, err = state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.Equal(t, int32(5), updateJobRequest.SetJobSyncOptionsRequest.GetSyncOptions().GetRetryPolicy().GetMaximumAttempts())
+	require.Equal(t, int64(3600), updateJobRequest.SetJobWorkflowOptionsRequest.GetWorfklowOptions().GetRunTimeout())
+
+	// removing the options clears them on the job
+	plan.SyncOptions = nil
+	updateJobRequest, err = state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.NotNil(t, updateJobRequest.SetJobSyncOptionsRequest)
+	require.Nil(t, updateJobRequest.SetJobSyncOptionsRequest.GetSyncOptions().StartToCloseTimeout)
+	require.Nil(t, updateJobRequest.SetJobSyncOptionsRequest.GetSyncOptions().GetRetryPolicy())
+}
+
+func Test_JobResourceModel_ToUpdateJobDto_Destinations(t *testing.T) {
+	state := newTestUpdateJobModel()
+
+	// a destination whose id is not yet known is still updated through its key
+	plan := newTestUpdateJobModel()
+	plan.Destinations["first"].Id = types.StringUnknown()
+	updateJobRequest, err := state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.Nil(t, updateJobRequest.CreateJobDestinationConnectionsRequest)
+	require.Empty(t, updateJobRequest.UpdateJobDestinationConnectionRequests)
+	require.Empty(t, updateJobRequest.DeleteJobDestinationConnectionRequests)
+
+	// a renamed key is paired with the state destination that uses the same connection
+	plan = newTestUpdateJobModel()
+	plan.Destinations["renamed"] = plan.Destinations["second"]
+	plan.Destinations["renamed"].Id = types.StringUnknown()
+	delete(plan.Destinations, "second")
+	updateJobRequest, err = state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.Nil(t, updateJobRequest.CreateJobDestinationConnectionsRequest)
+	require.Empty(t, updateJobRequest.UpdateJobDestinationConnectionRequests)
+	require.Empty(t, updateJobRequest.DeleteJobDestinationConnectionRequests)
+
+	// a destination moved to a different connection under a new key is replaced
+	plan.Destinations["renamed"].ConnectionId = types.StringValue("conn-3")
+	updateJobRequest, err = state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.Len(t, updateJobRequest.CreateJobDestinationConnectionsRequest.GetDestinations(), 1)
+	require.Equal(t, "conn-3", updateJobRequest.CreateJobDestinationConnectionsRequest.GetDestinations()[0].GetConnectionId())
+	require.Len(t, updateJobRequest.DeleteJobDestinationConnectionRequests, 1)
+	require.Equal(t, "dst-2", updateJobRequest.DeleteJobDestinationConnectionRequests[0].GetDestinationId())
+}
+
+func Test_JobResourceModel_ToUpdateJobDto_UpgradedDestinationKeys(t *testing.T) {
+	// the state upgrader keys destinations by their connection id, while configs use friendly keys
+	state := newTestUpdateJobModel()
+	state.Destinations = map[string]*JobDestination{
+		"conn-1": {
+			Id:           types.StringValue("dst-1"),
+			ConnectionId: types.StringValue("conn-1"),
+			Postgres:     &JobDestinationPostgresOptions{InitTableSchema: types.BoolValue(false)},
+		},
+	}
+	plan := newTestUpdateJobModel()
+	plan.Destinations = map[string]*JobDestination{
+		"stage": {
+			Id:           types.StringUnknown(),
+			ConnectionId: types.StringValue("conn-1"),
+			Postgres:     &JobDestinationPostgresOptions{InitTableSchema: types.BoolValue(true)},
+		},
+	}
+
+	updateJobRequest, err := state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.Nil(t, updateJobRequest.CreateJobDestinationConnectionsRequest)
+	require.Empty(t, updateJobRequest.DeleteJobDestinationConnectionRequests)
+	require.Len(t, updateJobRequest.UpdateJobDestinationConnectionRequests, 1)
+	require.Equal(t, "dst-1", updateJobRequest.UpdateJobDestinationConnectionRequests[0].GetDestinationId())
+	require.Equal(t, "conn-1", updateJobRequest.UpdateJobDestinationConnectionRequests[0].GetConnectionId())
+	require.True(t, updateJobRequest.UpdateJobDestinationConnectionRequests[0].GetOptions().GetPostgresOptions().GetInitTableSchema())
+}
+
+func Test_JobResourceModel_ToUpdateJobDto_ReorderedMappings(t *testing.T) {
+	state := newTestUpdateJobModel()
+	state.Mappings = []*JobMapping{newTestPassthroughJobMapping("id"), newTestPassthroughJobMapping("email")}
+	plan := newTestUpdateJobModel()
+	plan.Mappings = []*JobMapping{newTestPassthroughJobMapping("email"), newTestPassthroughJobMapping("id")}
+
+	updateJobRequest, err := state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.Nil(t, updateJobRequest.UpdateJobSourceConnectionRequest)
+}
+
+func Test_JobResourceModel_ToUpdateJobDto_UnmanagedMappings(t *testing.T) {
+	state := newTestUpdateJobModel()
+	state.ManageMappings = types.BoolValue(false)
+	state.Mappings = nil
+	plan := newTestUpdateJobModel()
+	plan.ManageMappings = types.BoolValue(false)
+	plan.Mappings = nil
+
+	updateJobRequest, err := state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.True(t, updateJobRequest.KeepCurrentMappings)
+	require.Nil(t, updateJobRequest.UpdateJobSourceConnectionRequest)
+
+	plan.JobSource.Postgres.ConnectionId = types.StringValue("source-2")
+	updateJobRequest, err = state.ToUpdateJobDto(plan, "job-1")
+	require.NoError(t, err)
+	require.Equal(t, "source-2", updateJobRequest.UpdateJobSourceConnectionRequest.GetSource().GetOptions().GetPostgres().GetConnectionId())
+	require.Empty(t, updateJobRequest.UpdateJobSourceConnectionRequest.GetMappings())
+}
+
+func Test_JobResourceModel_ToUpdateJobDto_Paused(t *testing.T) {
+	testcases := []struct {
+		name     string
+		state    types.Bool
+		plan     types.Bool
+		expected types.Bool
+	}{
+		{"unchanged", types.BoolValue(false), types.BoolValue(false), types.BoolNull()},
+		{"paused", types.BoolValue(false), types.BoolValue(true), types.BoolValue(true)},
+		{"resumed", types.BoolValue(true), types.BoolValue(false), types.BoolValue(false)},
+		{"not configured", types.BoolValue(true), types.BoolNull(), types.BoolNull()},
+		{"unknown", types.BoolValue(true), types.BoolUnknown(), types.BoolNull()},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := newTestUpdateJobModel()
+			state.Paused = tc.state
+			plan := newTestUpdateJobModel()
+			plan.Paused = tc.plan
+
+			updateJobRequest, err := state.ToUpdateJobDto(plan, "job-1")
+			require.NoError(t, err)
+			if tc.expected.IsNull() {
+				require.Nil(t, updateJobRequest.PauseJobRequest)
+				return
+			}
+			require.Equal(t, "job-1", updateJobRequest.PauseJobRequest.GetId())
+			require.Equal(t, tc.expected.ValueBool(), updateJobRequest.PauseJobRequest.GetPause())
+		})
+	}
+}
+
+func newTestJobMapping(schema, table, column string) *JobMapping {
+	return &JobMapping{
+		Schema: types.StringValue(schema),
+		Table:  types.StringValue(table),
+		Column: types.StringValue(column),
+	}
+}
+
+func newTestJobDestination(id, connectionId string) *JobDestination {
+	return &JobDestination{
+		Id:           types.StringValue(id),
+		ConnectionId: types.StringValue(connectionId),
+	}
+}
+
+func newTestPassthroughJobMapping(column string) *JobMapping {
+	mapping := newTestJobMapping("public", "users", column)
+	mapping.Transformer = &transformer_model.Transformer{
+		Config: &transformer_model.TransformerConfig{Passthrough: &transformer_model.TransformerEmpty{}},
+	}
+	return mapping
+}
+
+// The smallest job that ToUpdateJobDto can convert, with two destinations keyed by name
+func newTestUpdateJobModel() *JobResourceModel {
+	return &JobResourceModel{
+		Id:           types.StringValue("job-1"),
+		CronSchedule: types.StringValue("0 0 * * *"),
+		Paused:       types.BoolValue(false),
+		JobSource: &JobSource{
+			Postgres: &JobSourcePostgresOptions{
+				ConnectionId:                  types.StringValue("source-1"),
+				SubsetByForeignKeyConstraints: types.BoolValue(false),
+			},
+		},
+		Destinations: map[string]*JobDestination{
+			"first": {
+				Id:           types.StringValue("dst-1"),
+				ConnectionId: types.StringValue("conn-1"),
+				Postgres:     &JobDestinationPostgresOptions{InitTableSchema: types.BoolValue(false)},
+			},
+			"second": {
+				Id:           types.StringValue("dst-2"),
+				ConnectionId: types.StringValue("conn-2"),
+				Postgres:     &JobDestinationPostgresOptions{InitTableSchema: types.BoolValue(false)},
+			},
+		},
+		Mappings: []*JobMapping{newTestPassthroughJobMapping("id")},
+	}
+}
@@ -0,0 +1,253 @@
+package job_model
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	transformer_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/transformers"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JobSourceMysqlOptions_SchemaStrategies(t *testing.T) {
+	testcases := []struct {
+		name  string
+		input *JobSourceMysqlOptions
+	}{
+		{
+			name: "defaults",
+			input: &JobSourceMysqlOptions{
+				ConnectionId:                  types.StringValue("conn-1"),
+				SubsetByForeignKeyConstraints: types.BoolValue(false),
+			},
+		},
+		{
+			name: "halt job",
+			input: &JobSourceMysqlOptions{
+				ConnectionId:                  types.StringValue("conn-1"),
+				SubsetByForeignKeyConstraints: types.BoolValue(false),
+				NewColumnAdditionStrategy:     &MysqlNewColumnAdditionStrategy{HaltJob: &MysqlNewColumnAdditionStrategyHaltJob{}},
+				ColumnRemovalStrategy:         &MysqlColumnRemovalStrategy{HaltJob: &MysqlHaltJobColumnRemovalStrategy{}},
+			},
+		},
+		{
+			name: "continue job",
+			input: &JobSourceMysqlOptions{
+				ConnectionId:                  types.StringValue("conn-1"),
+				SubsetByForeignKeyConstraints: types.BoolValue(true),
+				ColumnRemovalStrategy:         &MysqlColumnRemovalStrategy{ContinueJob: &MysqlContinueJobColumnRemovalStrategy{}},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dto, err := tc.input.ToDto()
+			require.NoError(t, err)
+			require.Equal(t, tc.input.NewColumnAdditionStrategy != nil, dto.Mysql.GetHaltOnNewColumnAddition())
+
+			actual := &JobSourceMysqlOptions{}
+			err = actual.FromDto(dto.Mysql)
+			require.NoError(t, err)
+			require.Equal(t, tc.input, actual)
+		})
+	}
+}
+
+func Test_JobSourceMssqlOptions_SchemaStrategies(t *testing.T) {
+	testcases := []struct {
+		name  string
+		input *JobSourceMssqlOptions
+	}{
+		{
+			name: "defaults",
+			input: &JobSourceMssqlOptions{
+				ConnectionId:                  types.StringValue("conn-1"),
+				SubsetByForeignKeyConstraints: types.BoolValue(false),
+			},
+		},
+		{
+			name: "halt job",
+			input: &JobSourceMssqlOptions{
+				ConnectionId:                  types.StringValue("conn-1"),
+				SubsetByForeignKeyConstraints: types.BoolValue(false),
+				NewColumnAdditionStrategy:     &MssqlNewColumnAdditionStrategy{HaltJob: &MssqlNewColumnAdditionStrategyHaltJob{}},
+				ColumnRemovalStrategy:         &MssqlColumnRemovalStrategy{HaltJob: &MssqlHaltJobColumnRemovalStrategy{}},
+			},
+		},
+		{
+			name: "continue job",
+			input: &JobSourceMssqlOptions{
+				ConnectionId:                  types.StringValue("conn-1"),
+				SubsetByForeignKeyConstraints: types.BoolValue(true),
+				ColumnRemovalStrategy:         &MssqlColumnRemovalStrategy{ContinueJob: &MssqlContinueJobColumnRemovalStrategy{}},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dto, err := tc.input.ToDto()
+			require.NoError(t, err)
+			require.Equal(t, tc.input.NewColumnAdditionStrategy != nil, dto.Mssql.GetHaltOnNewColumnAddition())
+
+			actual := &JobSourceMssqlOptions{}
+			err = actual.FromDto(dto.Mssql)
+			require.NoError(t, err)
+			require.Equal(t, tc.input, actual)
+		})
+	}
+}
+
+func Test_JobSource_RoundTrip(t *testing.T) {
+	passthrough := &transformer_model.Transformer{
+		Config: &transformer_model.TransformerConfig{Passthrough: &transformer_model.TransformerEmpty{}},
+	}
+	null := &transformer_model.Transformer{
+		Config: &transformer_model.TransformerConfig{Null: &transformer_model.TransformerEmpty{}},
+	}
+
+	testcases := []struct {
+		name  string
+		input *JobSource
+	}{
+		{
+			name: "mssql",
+			input: &JobSource{Mssql: &JobSourceMssqlOptions{
+				ConnectionId:                  types.StringValue("conn-1"),
+				SubsetByForeignKeyConstraints: types.BoolValue(true),
+				SchemaOptions: []*JobSourceMssqlSourceSchemaOption{{
+					Schema: types.StringValue("dbo"),
+					Tables: []*JobSourceMssqlSourceTableOption{
+						{Table: types.StringValue("users"), WhereClause: types.StringValue("id > 1")},
+						{Table: types.StringValue("orders"), WhereClause: types.StringNull()},
+					},
+				}},
+			}},
+		},
+		{
+			name:  "mongodb",
+			input: &JobSource{Mongodb: &JobSourceMongodbOptions{ConnectionId: types.StringValue("conn-1")}},
+		},
+		{
+			name: "dynamodb defaults",
+			input: &JobSource{Dynamodb: &JobSourceDynamodbOptions{
+				ConnectionId:         types.StringValue("conn-1"),
+				EnableConsistentRead: types.BoolValue(false),
+			}},
+		},
+		{
+			name: "dynamodb",
+			input: &JobSource{Dynamodb: &JobSourceDynamodbOptions{
+				ConnectionId:         types.StringValue("conn-1"),
+				EnableConsistentRead: types.BoolValue(true),
+				Tables: []*JobSourceDynamodbTableOption{
+					{Table: types.StringValue("users"), WhereClause: types.StringValue("age > 1")},
+				},
+				UnmappedTransforms: &DynamodbUnmappedTransformConfig{
+					B: passthrough,
+					S: null,
+				},
+			}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dto, err := tc.input.ToDto()
+			require.NoError(t, err)
+
+			actual := &JobSource{}
+			err = actual.FromDto(dto)
+			require.NoError(t, err)
+			require.Equal(t, tc.input, actual)
+		})
+	}
+}
+
+func Test_JobSourceDynamodbOptions_ToDto_UnmappedTransforms(t *testing.T) {
+	input := &JobSourceDynamodbOptions{
+		ConnectionId: types.StringValue("conn-1"),
+		UnmappedTransforms: &DynamodbUnmappedTransformConfig{
+			N: &transformer_model.Transformer{
+				Config: &transformer_model.TransformerConfig{Passthrough: &transformer_model.TransformerEmpty{}},
+			},
+		},
+	}
+
+	dto, err := input.ToDto()
+	require.NoError(t, err)
+	unmapped := dto.Dynamodb.GetUnmappedTransforms()
+	require.NotNil(t, unmapped.GetN().GetConfig().GetPassthroughConfig())
+	require.Nil(t, unmapped.GetB())
+	require.Nil(t, unmapped.GetBoolean())
+	require.Nil(t, unmapped.GetS())
+}
+
+func Test_JobSourceAiGenerateOptions_RoundTrip(t *testing.T) {
+	testcases := []struct {
+		name  string
+		input *JobSourceAiGenerateOptions
+	}{
+		{
+			name: "defaults",
+			input: &JobSourceAiGenerateOptions{
+				AiConnectionId:       types.StringValue("ai-conn-1"),
+				FkSourceConnectionId: types.StringNull(),
+				ModelName:            types.StringValue("gpt-4o-mini"),
+				UserPrompt:           types.StringNull(),
+				GenerateBatchSize:    types.Int64Null(),
+				Schemas:              []*JobSourceAiGenerateSchemaOption{},
+			},
+		},
+		{
+			name: "full",
+			input: &JobSourceAiGenerateOptions{
+				AiConnectionId:       types.StringValue("ai-conn-1"),
+				FkSourceConnectionId: types.StringValue("conn-1"),
+				ModelName:            types.StringValue("gpt-4o-mini"),
+				UserPrompt:           types.StringValue("generate users"),
+				GenerateBatchSize:    types.Int64Value(10),
+				Schemas: []*JobSourceAiGenerateSchemaOption{
+					{
+						Schema: types.StringValue("public"),
+						Tables: []*JobSourceAiGenerateTableOption{{Table: types.StringValue("users"), RowCount: types.Int64Value(100)}},
+					},
+					{
+						Schema: types.StringValue("empty"),
+						Tables: []*JobSourceAiGenerateTableOption{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dto, err := tc.input.ToDto()
+			require.NoError(t, err)
+
+			actual := &JobSourceAiGenerateOptions{}
+			err = actual.FromDto(dto.AiGenerate)
+			require.NoError(t, err)
+			require.Equal(t, tc.input, actual)
+		})
+	}
+}
+
+func Test_JobSourceAiGenerateOptions_FromDto_EmptySchemas(t *testing.T) {
+	actual := &JobSourceAiGenerateOptions{}
+	err := actual.FromDto(&mgmtv1alpha1.AiGenerateSourceOptions{
+		AiConnectionId: "ai-conn-1",
+		ModelName:      "gpt-4o-mini",
+		Schemas:        []*mgmtv1alpha1.AiGenerateSourceSchemaOption{{Schema: "public"}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, actual.Schemas[0].Tables)
+	require.Empty(t, actual.Schemas[0].Tables)
+
+	err = actual.FromDto(&mgmtv1alpha1.AiGenerateSourceOptions{AiConnectionId: "ai-conn-1", ModelName: "gpt-4o-mini"})
+	require.NoError(t, err)
+	require.NotNil(t, actual.Schemas)
+	require.Empty(t, actual.Schemas)
+}
@@ -0,0 +1,261 @@
+package job_model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+type JobSourceSubsetResourceModel struct {
+	Id                            types.String             `tfsdk:"id"`
+	JobId                         types.String             `tfsdk:"job_id"`
+	SubsetByForeignKeyConstraints types.Bool               `tfsdk:"subset_by_foreign_key_constraints"`
+	Schemas                       []*JobSourceSubsetSchema `tfsdk:"schemas"`
+	Tables                        []*JobSourceSubsetTable  `tfsdk:"tables"`
+}
+
+type JobSourceSubsetSchema struct {
+	Schema types.String            `tfsdk:"schema"`
+	Tables []*JobSourceSubsetTable `tfsdk:"tables"`
+}
+
+type JobSourceSubsetTable struct {
+	Table       types.String `tfsdk:"table"`
+	WhereClause types.String `tfsdk:"where_clause"`
+}
+
+// Builds the subset request for the job's current source. The shape of the subsets depends on the type of the source:
+// sql sources are subset by schema, while dynamodb sources are subset by table.
+func (j *JobSourceSubsetResourceModel) ToDto(source *mgmtv1alpha1.JobSource) (*mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest, error) {
+	if j == nil {
+		return nil, errors.New("job source subset resource model is nil")
+	}
+
+	dto := &mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest{
+		Id:                            j.JobId.ValueString(),
+		SubsetByForeignKeyConstraints: j.SubsetByForeignKeyConstraints.ValueBool(),
+	}
+
+	switch source.GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		if err := j.validateSqlSubsets("postgres"); err != nil {
+			return nil, err
+		}
+		schemas := toSubsetSchemaDtos(j.Schemas,
+			func(schema string, tables []*mgmtv1alpha1.PostgresSourceTableOption) *mgmtv1alpha1.PostgresSourceSchemaOption {
+				return &mgmtv1alpha1.PostgresSourceSchemaOption{Schema: schema, Tables: tables}
+			},
+			func(table string, whereClause *string) *mgmtv1alpha1.PostgresSourceTableOption {
+				return &mgmtv1alpha1.PostgresSourceTableOption{Table: table, WhereClause: whereClause}
+			},
+		)
+		dto.Schemas = &mgmtv1alpha1.JobSourceSqlSubetSchemas{
+			Schemas: &mgmtv1alpha1.JobSourceSqlSubetSchemas_PostgresSubset{
+				PostgresSubset: &mgmtv1alpha1.PostgresSourceSchemaSubset{PostgresSchemas: schemas},
+			},
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		if err := j.validateSqlSubsets("mysql"); err != nil {
+			return nil, err
+		}
+		schemas := toSubsetSchemaDtos(j.Schemas,
+			func(schema string, tables []*mgmtv1alpha1.MysqlSourceTableOption) *mgmtv1alpha1.MysqlSourceSchemaOption {
+				return &mgmtv1alpha1.MysqlSourceSchemaOption{Schema: schema, Tables: tables}
+			},
+			func(table string, whereClause *string) *mgmtv1alpha1.MysqlSourceTableOption {
+				return &mgmtv1alpha1.MysqlSourceTableOption{Table: table, WhereClause: whereClause}
+			},
+		)
+		dto.Schemas = &mgmtv1alpha1.JobSourceSqlSubetSchemas{
+			Schemas: &mgmtv1alpha1.JobSourceSqlSubetSchemas_MysqlSubset{
+				MysqlSubset: &mgmtv1alpha1.MysqlSourceSchemaSubset{MysqlSchemas: schemas},
+			},
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		if err := j.validateSqlSubsets("mssql"); err != nil {
+			return nil, err
+		}
+		schemas := toSubsetSchemaDtos(j.Schemas,
+			func(schema string, tables []*mgmtv1alpha1.MssqlSourceTableOption) *mgmtv1alpha1.MssqlSourceSchemaOption {
+				return &mgmtv1alpha1.MssqlSourceSchemaOption{Schema: schema, Tables: tables}
+			},
+			func(table string, whereClause *string) *mgmtv1alpha1.MssqlSourceTableOption {
+				return &mgmtv1alpha1.MssqlSourceTableOption{Table: table, WhereClause: whereClause}
+			},
+		)
+		dto.Schemas = &mgmtv1alpha1.JobSourceSqlSubetSchemas{
+			Schemas: &mgmtv1alpha1.JobSourceSqlSubetSchemas_MssqlSubset{
+				MssqlSubset: &mgmtv1alpha1.MssqlSourceSchemaSubset{MssqlSchemas: schemas},
+			},
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Dynamodb:
+		if len(j.Schemas) > 0 {
+			return nil, errors.New("dynamodb sources are subset by tables, schemas can not be configured")
+		}
+		if j.SubsetByForeignKeyConstraints.ValueBool() {
+			return nil, errors.New("subset_by_foreign_key_constraints is not supported for dynamodb sources")
+		}
+		tables := make([]*mgmtv1alpha1.DynamoDBSourceTableOption, 0, len(j.Tables))
+		for _, table := range j.Tables {
+			tables = append(tables, &mgmtv1alpha1.DynamoDBSourceTableOption{
+				Table:       table.Table.ValueString(),
+				WhereClause: table.WhereClause.ValueStringPointer(),
+			})
+		}
+		dto.Schemas = &mgmtv1alpha1.JobSourceSqlSubetSchemas{
+			Schemas: &mgmtv1alpha1.JobSourceSqlSubetSchemas_DynamodbSubset{
+				DynamodbSubset: &mgmtv1alpha1.DynamoDBSourceSchemaSubset{Tables: tables},
+			},
+		}
+	default:
+		return nil, fmt.Errorf("the job source does not support subsets: %T", source.GetOptions().GetConfig())
+	}
+	return dto, nil
+}
+
+func (j *JobSourceSubsetResourceModel) validateSqlSubsets(sourceType string) error {
+	if len(j.Tables) > 0 {
+		return fmt.Errorf("%s sources are subset by schemas, tables can not be configured at the top level", sourceType)
+	}
+	return nil
+}
+
+func (j *JobSourceSubsetResourceModel) FromDto(jobId string, source *mgmtv1alpha1.JobSource) error {
+	if j == nil {
+		return errors.New("job source subset resource model is nil")
+	}
+	if source == nil {
+		return errors.New("job source dto is nil")
+	}
+
+	j.Id = types.StringValue(jobId)
+	j.JobId = types.StringValue(jobId)
+	j.Schemas = nil
+	j.Tables = nil
+
+	switch config := source.GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		j.SubsetByForeignKeyConstraints = types.BoolValue(config.Postgres.GetSubsetByForeignKeyConstraints())
+		j.Schemas = fromSubsetSchemaDtos(config.Postgres.GetSchemas(),
+			func(schema *mgmtv1alpha1.PostgresSourceSchemaOption) (string, []*mgmtv1alpha1.PostgresSourceTableOption) {
+				return schema.GetSchema(), schema.GetTables()
+			},
+			func(table *mgmtv1alpha1.PostgresSourceTableOption) (string, *string) {
+				return table.GetTable(), table.WhereClause
+			},
+		)
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		j.SubsetByForeignKeyConstraints = types.BoolValue(config.Mysql.GetSubsetByForeignKeyConstraints())
+		j.Schemas = fromSubsetSchemaDtos(config.Mysql.GetSchemas(),
+			func(schema *mgmtv1alpha1.MysqlSourceSchemaOption) (string, []*mgmtv1alpha1.MysqlSourceTableOption) {
+				return schema.GetSchema(), schema.GetTables()
+			},
+			func(table *mgmtv1alpha1.MysqlSourceTableOption) (string, *string) {
+				return table.GetTable(), table.WhereClause
+			},
+		)
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		j.SubsetByForeignKeyConstraints = types.BoolValue(config.Mssql.GetSubsetByForeignKeyConstraints())
+		j.Schemas = fromSubsetSchemaDtos(config.Mssql.GetSchemas(),
+			func(schema *mgmtv1alpha1.MssqlSourceSchemaOption) (string, []*mgmtv1alpha1.MssqlSourceTableOption) {
+				return schema.GetSchema(), schema.GetTables()
+			},
+			func(table *mgmtv1alpha1.MssqlSourceTableOption) (string, *string) {
+				return table.GetTable(), table.WhereClause
+			},
+		)
+	case *mgmtv1alpha1.JobSourceOptions_Dynamodb:
+		j.SubsetByForeignKeyConstraints = types.BoolValue(false)
+		for _, tableDto := range config.Dynamodb.GetTables() {
+			j.Tables = append(j.Tables, &JobSourceSubsetTable{
+				Table:       types.StringValue(tableDto.GetTable()),
+				WhereClause: types.StringPointerValue(tableDto.WhereClause),
+			})
+		}
+	default:
+		return fmt.Errorf("the job source does not support subsets: %T", config)
+	}
+	return nil
+}
+
+// The postgres, mysql and mssql sources each have their own schema and table option types with the same fields,
+// so the subsets are converted once and each source only provides the constructors for its types.
+func toSubsetSchemaDtos[S, T any](
+	schemas []*JobSourceSubsetSchema,
+	newSchema func(schema string, tables []T) S,
+	newTable func(table string, whereClause *string) T,
+) []S {
+	output := make([]S, 0, len(schemas))
+	for _, schema := range schemas {
+		tables := make([]T, 0, len(schema.Tables))
+		for _, table := range schema.Tables {
+			tables = append(tables, newTable(table.Table.ValueString(), table.WhereClause.ValueStringPointer()))
+		}
+		output = append(output, newSchema(schema.Schema.ValueString(), tables))
+	}
+	return output
+}
+
+// The inverse of toSubsetSchemaDtos, where each source provides the accessors for its types.
+// Returns nil when there are no schemas, which is why the resource rejects an empty schemas list.
+func fromSubsetSchemaDtos[S, T any](
+	schemas []S,
+	schemaFields func(schema S) (string, []T),
+	tableFields func(table T) (string, *string),
+) []*JobSourceSubsetSchema {
+	var output []*JobSourceSubsetSchema
+	for _, schemaDto := range schemas {
+		schemaName, tableDtos := schemaFields(schemaDto)
+		schema := &JobSourceSubsetSchema{Schema: types.StringValue(schemaName), Tables: make([]*JobSourceSubsetTable, 0, len(tableDtos))}
+		for _, tableDto := range tableDtos {
+			tableName, whereClause := tableFields(tableDto)
+			schema.Tables = append(schema.Tables, &JobSourceSubsetTable{
+				Table:       types.StringValue(tableName),
+				WhereClause: types.StringPointerValue(whereClause),
+			})
+		}
+		output = append(output, schema)
+	}
+	return output
+}
+
+// Returns a request that clears all of the subsets of the job's current source
+func NewClearJobSourceSubsetsRequest(jobId string, source *mgmtv1alpha1.JobSource) (*mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest, error) {
+	empty := &JobSourceSubsetResourceModel{
+		JobId:                         types.StringValue(jobId),
+		SubsetByForeignKeyConstraints: types.BoolValue(false),
+	}
+	return empty.ToDto(source)
+}
+
+// Copies the subsets of the current source onto the new source, leaving all of the new source's other options as they are.
+// This is a no-op if the two sources are not of the same type.
+func CopyJobSourceSubsets(dst, current *mgmtv1alpha1.JobSource) {
+	switch config := dst.GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		if config.Postgres == nil || current.GetOptions().GetPostgres() == nil {
+			return
+		}
+		config.Postgres.Schemas = current.GetOptions().GetPostgres().GetSchemas()
+		config.Postgres.SubsetByForeignKeyConstraints = current.GetOptions().GetPostgres().GetSubsetByForeignKeyConstraints()
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		if config.Mysql == nil || current.GetOptions().GetMysql() == nil {
+			return
+		}
+		config.Mysql.Schemas = current.GetOptions().GetMysql().GetSchemas()
+		config.Mysql.SubsetByForeignKeyConstraints = current.GetOptions().GetMysql().GetSubsetByForeignKeyConstraints()
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		if config.Mssql == nil || current.GetOptions().GetMssql() == nil {
+			return
+		}
+		config.Mssql.Schemas = current.GetOptions().GetMssql().GetSchemas()
+		config.Mssql.SubsetByForeignKeyConstraints = current.GetOptions().GetMssql().GetSubsetByForeignKeyConstraints()
+	case *mgmtv1alpha1.JobSourceOptions_Dynamodb:
+		if config.Dynamodb == nil || current.GetOptions().GetDynamodb() == nil {
+			return
+		}
+		config.Dynamodb.Tables = current.GetOptions().GetDynamodb().GetTables()
+	}
+}
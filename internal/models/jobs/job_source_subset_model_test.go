@@ -0,0 +1,153 @@
+package job_model
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JobSourceSubsetResourceModel_ToDto_Postgres(t *testing.T) {
+	source := &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Postgres{Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: "conn-1"}},
+	}}
+	model := &JobSourceSubsetResourceModel{
+		JobId:                         types.StringValue("job-1"),
+		SubsetByForeignKeyConstraints: types.BoolValue(true),
+		Schemas: []*JobSourceSubsetSchema{{
+			Schema: types.StringValue("public"),
+			Tables: []*JobSourceSubsetTable{
+				{Table: types.StringValue("users"), WhereClause: types.StringValue("id > 1")},
+				{Table: types.StringValue("orders"), WhereClause: types.StringNull()},
+			},
+		}},
+	}
+
+	dto, err := model.ToDto(source)
+	require.NoError(t, err)
+	require.Equal(t, "job-1", dto.GetId())
+	require.True(t, dto.GetSubsetByForeignKeyConstraints())
+	schemas := dto.GetSchemas().GetPostgresSubset().GetPostgresSchemas()
+	require.Len(t, schemas, 1)
+	require.Equal(t, "id > 1", schemas[0].GetTables()[0].GetWhereClause())
+	require.Nil(t, schemas[0].GetTables()[1].WhereClause)
+
+	// the backend stores the subsets on the source, so they are read back from there
+	source.GetOptions().GetPostgres().Schemas = schemas
+	source.GetOptions().GetPostgres().SubsetByForeignKeyConstraints = true
+	actual := &JobSourceSubsetResourceModel{}
+	err = actual.FromDto("job-1", source)
+	require.NoError(t, err)
+	model.Id = types.StringValue("job-1")
+	require.Equal(t, model, actual)
+}
+
+func Test_JobSourceSubsetResourceModel_ToDto_MysqlMssql(t *testing.T) {
+	model := &JobSourceSubsetResourceModel{
+		JobId:                         types.StringValue("job-1"),
+		SubsetByForeignKeyConstraints: types.BoolValue(false),
+		Schemas: []*JobSourceSubsetSchema{
+			{
+				Schema: types.StringValue("app"),
+				Tables: []*JobSourceSubsetTable{{Table: types.StringValue("users"), WhereClause: types.StringValue("id > 1")}},
+			},
+			{
+				Schema: types.StringValue("empty"),
+				Tables: []*JobSourceSubsetTable{},
+			},
+		},
+	}
+
+	t.Run("mysql", func(t *testing.T) {
+		source := &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_Mysql{Mysql: &mgmtv1alpha1.MysqlSourceConnectionOptions{ConnectionId: "conn-1"}},
+		}}
+		dto, err := model.ToDto(source)
+		require.NoError(t, err)
+		schemas := dto.GetSchemas().GetMysqlSubset().GetMysqlSchemas()
+		require.Len(t, schemas, 2)
+
+		source.GetOptions().GetMysql().Schemas = schemas
+		actual := &JobSourceSubsetResourceModel{}
+		err = actual.FromDto("job-1", source)
+		require.NoError(t, err)
+		require.Equal(t, model.Schemas, actual.Schemas)
+	})
+
+	t.Run("mssql", func(t *testing.T) {
+		source := &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_Mssql{Mssql: &mgmtv1alpha1.MssqlSourceConnectionOptions{ConnectionId: "conn-1"}},
+		}}
+		dto, err := model.ToDto(source)
+		require.NoError(t, err)
+		schemas := dto.GetSchemas().GetMssqlSubset().GetMssqlSchemas()
+		require.Len(t, schemas, 2)
+
+		source.GetOptions().GetMssql().Schemas = schemas
+		actual := &JobSourceSubsetResourceModel{}
+		err = actual.FromDto("job-1", source)
+		require.NoError(t, err)
+		require.Equal(t, model.Schemas, actual.Schemas)
+	})
+}
+
+func Test_JobSourceSubsetResourceModel_ToDto_Dynamodb(t *testing.T) {
+	source := &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Dynamodb{Dynamodb: &mgmtv1alpha1.DynamoDBSourceConnectionOptions{ConnectionId: "conn-1"}},
+	}}
+
+	model := &JobSourceSubsetResourceModel{
+		JobId:                         types.StringValue("job-1"),
+		SubsetByForeignKeyConstraints: types.BoolValue(false),
+		Tables:                        []*JobSourceSubsetTable{{Table: types.StringValue("users"), WhereClause: types.StringValue("age > 1")}},
+	}
+	dto, err := model.ToDto(source)
+	require.NoError(t, err)
+	require.Equal(t, "age > 1", dto.GetSchemas().GetDynamodbSubset().GetTables()[0].GetWhereClause())
+
+	model.Schemas = []*JobSourceSubsetSchema{{Schema: types.StringValue("public")}}
+	_, err = model.ToDto(source)
+	require.Error(t, err)
+
+	model.Schemas = nil
+	model.SubsetByForeignKeyConstraints = types.BoolValue(true)
+	_, err = model.ToDto(source)
+	require.Error(t, err)
+}
+
+func Test_JobSourceSubsetResourceModel_ToDto_Unsupported(t *testing.T) {
+	source := &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_AwsS3{AwsS3: &mgmtv1alpha1.AwsS3SourceConnectionOptions{ConnectionId: "conn-1"}},
+	}}
+	_, err := NewClearJobSourceSubsetsRequest("job-1", source)
+	require.Error(t, err)
+}
+
+func Test_CopyJobSourceSubsets(t *testing.T) {
+	whereClause := "id > 1"
+	current := &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Mysql{Mysql: &mgmtv1alpha1.MysqlSourceConnectionOptions{
+			ConnectionId:                  "conn-1",
+			SubsetByForeignKeyConstraints: true,
+			Schemas: []*mgmtv1alpha1.MysqlSourceSchemaOption{{
+				Schema: "public",
+				Tables: []*mgmtv1alpha1.MysqlSourceTableOption{{Table: "users", WhereClause: &whereClause}},
+			}},
+		}},
+	}}
+	updated := &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Mysql{Mysql: &mgmtv1alpha1.MysqlSourceConnectionOptions{ConnectionId: "conn-2"}},
+	}}
+
+	CopyJobSourceSubsets(updated, current)
+	require.Equal(t, "conn-2", updated.GetOptions().GetMysql().GetConnectionId())
+	require.True(t, updated.GetOptions().GetMysql().GetSubsetByForeignKeyConstraints())
+	require.Equal(t, current.GetOptions().GetMysql().GetSchemas(), updated.GetOptions().GetMysql().GetSchemas())
+
+	postgres := &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Postgres{Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: "conn-3"}},
+	}}
+	CopyJobSourceSubsets(postgres, current)
+	require.Empty(t, postgres.GetOptions().GetPostgres().GetSchemas())
+}
@@ -2,31 +2,47 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	connection_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/connections"
 )
 
 var _ datasource.DataSource = &ConnectionDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ConnectionDataSource{}
 
 func NewConnectionDataSource() datasource.DataSource {
 	return &ConnectionDataSource{}
 }
 
 type ConnectionDataSource struct {
-	client mgmtv1alpha1connect.ConnectionServiceClient
+	client    mgmtv1alpha1connect.ConnectionServiceClient
+	accountId *string
 }
 
 type ConnectionDataSourceModel struct {
-	Name types.String `tfsdk:"name"`
-	Id   types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Id        types.String `tfsdk:"id"`
+	AccountId types.String `tfsdk:"account_id"`
+
+	Type       types.String `tfsdk:"type"`
+	Host       types.String `tfsdk:"host"`
+	Port       types.Int64  `tfsdk:"port"`
+	Database   types.String `tfsdk:"database"`
+	SslMode    types.String `tfsdk:"ssl_mode"`
+	Bucket     types.String `tfsdk:"bucket"`
+	Region     types.String `tfsdk:"region"`
+	TunnelHost types.String `tfsdk:"tunnel_host"`
 }
 
 func (d *ConnectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,17 +55,65 @@ func (d *ConnectionDataSource) Schema(ctx context.Context, req datasource.Schema
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Description: "The unique name of the connection",
+				Description: "The unique name of the connection. Exactly one of id or name must be provided",
+				Optional:    true,
 				Computed:    true,
 			},
 			"id": schema.StringAttribute{
-				Description: "The unique identifier of the connection",
-				Required:    true,
+				Description: "The unique identifier of the connection. Exactly one of id or name must be provided",
+				Optional:    true,
+				Computed:    true,
+			},
+			"account_id": schema.StringAttribute{
+				Description: "The unique identifier of the account. Used when looking up the connection by name. Can be pulled from the API Key if present, or must be specified if using a user access token",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the connection",
+				Computed:    true,
+			},
+			"host": schema.StringAttribute{
+				Description: "The host name of the database server, if the connection was configured with discrete connection details",
+				Computed:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "The port of the database server, if the connection was configured with discrete connection details",
+				Computed:    true,
+			},
+			"database": schema.StringAttribute{
+				Description: "The name of the database, if the connection was configured with discrete connection details",
+				Computed:    true,
+			},
+			"ssl_mode": schema.StringAttribute{
+				Description: "The SSL mode of a postgres connection",
+				Computed:    true,
+			},
+			"bucket": schema.StringAttribute{
+				Description: "The name of the bucket of an AWS S3 connection",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "The region of an AWS S3 connection",
+				Computed:    true,
+			},
+			"tunnel_host": schema.StringAttribute{
+				Description: "The host name of the SSH tunnel, if one is configured",
+				Computed:    true,
 			},
 		},
 	}
 }
 
+func (d *ConnectionDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *ConnectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -68,6 +132,7 @@ func (d *ConnectionDataSource) Configure(ctx context.Context, req datasource.Con
 	}
 
 	d.client = providerData.ConnectionClient
+	d.accountId = providerData.AccountId
 }
 
 func (d *ConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -80,16 +145,129 @@ func (d *ConnectionDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	connResp, err := d.client.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
-		Id: data.Id.ValueString(),
-	}))
+	var connection *mgmtv1alpha1.Connection
+	if data.Id.ValueString() != "" {
+		connResp, err := d.client.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: data.Id.ValueString(),
+		}))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get connection by id", err.Error())
+			return
+		}
+		connection = connResp.Msg.GetConnection()
+	} else {
+		accountId, err := d.getAccountId(&data)
+		if err != nil {
+			resp.Diagnostics.AddError("no account id", err.Error())
+			return
+		}
+		connection, err = getConnectionByName(ctx, d.client, accountId, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get connection by name", err.Error())
+			return
+		}
+	}
+
+	err := data.fromDto(connection)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to get connection by id", err.Error())
+		resp.Diagnostics.AddError("connection model hydration error", err.Error())
 		return
 	}
-
-	data.Name = types.StringValue(connResp.Msg.Connection.Name)
 	tflog.Trace(ctx, "read connection", map[string]any{"id": data.Id.ValueString()})
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (d *ConnectionDataSource) getAccountId(data *ConnectionDataSourceModel) (string, error) {
+	var accountId string
+	if data.AccountId.ValueString() == "" {
+		if d.accountId != nil {
+			accountId = *d.accountId
+		}
+	} else {
+		accountId = data.AccountId.ValueString()
+	}
+	if accountId == "" {
+		return "", errors.New("must provide account id either on the data source or provide through environment configuration")
+	}
+	return accountId, nil
+}
+
+// Finds the connection with the given name in the account.
+// Returns an error if no connection or more than one connection has that name.
+func getConnectionByName(
+	ctx context.Context,
+	client mgmtv1alpha1connect.ConnectionServiceClient,
+	accountId string,
+	name string,
+) (*mgmtv1alpha1.Connection, error) {
+	connsResp, err := client.GetConnections(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionsRequest{
+		AccountId: accountId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return findOneByName(connsResp.Msg.GetConnections(), "connection", accountId, name)
+}
+
+func (d *ConnectionDataSourceModel) fromDto(dto *mgmtv1alpha1.Connection) error {
+	if dto == nil {
+		return errors.New("connection dto is nil")
+	}
+
+	d.Id = types.StringValue(dto.GetId())
+	d.Name = types.StringValue(dto.GetName())
+	d.AccountId = types.StringValue(dto.GetAccountId())
+	d.Type = types.StringValue(connection_model.GetConnectionType(dto.GetConnectionConfig()))
+	d.Host = types.StringNull()
+	d.Port = types.Int64Null()
+	d.Database = types.StringNull()
+	d.SslMode = types.StringNull()
+	d.Bucket = types.StringNull()
+	d.Region = types.StringNull()
+	d.TunnelHost = types.StringNull()
+
+	switch dto.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+	default:
+		// The remaining connection types are not yet modeled by the connection resource
+		return nil
+	}
+
+	model := connection_model.ConnectionResourceModel{}
+	err := model.FromConnectionConfigDto(dto.GetConnectionConfig())
+	if err != nil {
+		return err
+	}
+
+	if model.Postgres != nil {
+		d.Host = nullIfEmpty(model.Postgres.Host)
+		d.Port = model.Postgres.Port
+		d.Database = nullIfEmpty(model.Postgres.Name)
+		d.SslMode = model.Postgres.SslMode
+		if model.Postgres.Tunnel != nil {
+			d.TunnelHost = model.Postgres.Tunnel.Host
+		}
+	}
+	if model.Mysql != nil {
+		d.Host = nullIfEmpty(model.Mysql.Host)
+		d.Port = model.Mysql.Port
+		d.Database = nullIfEmpty(model.Mysql.Name)
+		if model.Mysql.Tunnel != nil {
+			d.TunnelHost = model.Mysql.Tunnel.Host
+		}
+	}
+	if model.AwsS3 != nil {
+		d.Bucket = model.AwsS3.Bucket
+		d.Region = model.AwsS3.Region
+	}
+	return nil
+}
+
+func nullIfEmpty(input types.String) types.String {
+	if input.ValueString() == "" {
+		return types.StringNull()
+	}
+	return input
+}
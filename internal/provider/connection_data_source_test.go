@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -37,3 +38,68 @@ data "neosync_connection" "test" {
 		},
 	})
 }
+
+func TestAcc_Connection_DataSource_ByName(t *testing.T) {
+	connectionName := acctest.RandString(10)
+	config := fmt.Sprintf(`
+resource "neosync_connection" "test" {
+  name = "%s"
+
+	postgres = {
+		host = "test-host"
+		port = 5432
+		name = "neosync"
+		user = "postgres"
+		pass = "postgres123"
+		ssl_mode = "disable"
+
+		tunnel = {
+			host = "bastion"
+			port = 22
+			user = "test"
+		}
+	}
+}
+data "neosync_connection" "test" {
+  name = neosync_connection.test.name
+}
+`, connectionName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.neosync_connection.test", "id", "neosync_connection.test", "id"),
+					resource.TestCheckResourceAttr("data.neosync_connection.test", "type", "postgres"),
+					resource.TestCheckResourceAttr("data.neosync_connection.test", "host", "test-host"),
+					resource.TestCheckResourceAttr("data.neosync_connection.test", "port", "5432"),
+					resource.TestCheckResourceAttr("data.neosync_connection.test", "database", "neosync"),
+					resource.TestCheckResourceAttr("data.neosync_connection.test", "ssl_mode", "disable"),
+					resource.TestCheckResourceAttr("data.neosync_connection.test", "tunnel_host", "bastion"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Connection_DataSource_IdAndName(t *testing.T) {
+	config := `
+data "neosync_connection" "test" {
+  id   = "3b83d1d3-5ffe-48c6-ac11-7a2e60802864"
+  name = "prod-replica"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
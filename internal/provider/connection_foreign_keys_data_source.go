@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+)
+
+var _ datasource.DataSource = &ConnectionForeignKeysDataSource{}
+
+func NewConnectionForeignKeysDataSource() datasource.DataSource {
+	return &ConnectionForeignKeysDataSource{}
+}
+
+type ConnectionForeignKeysDataSource struct {
+	client mgmtv1alpha1connect.ConnectionDataServiceClient
+}
+
+type ConnectionForeignKeysDataSourceModel struct {
+	ConnectionId types.String                      `tfsdk:"connection_id"`
+	ForeignKeys  []*ConnectionForeignKeyConstraint `tfsdk:"foreign_keys"`
+	PrimaryKeys  []*ConnectionPrimaryKeyConstraint `tfsdk:"primary_keys"`
+}
+
+type ConnectionForeignKeyConstraint struct {
+	Schema      types.String          `tfsdk:"schema"`
+	Table       types.String          `tfsdk:"table"`
+	Columns     []types.String        `tfsdk:"columns"`
+	NotNullable []types.Bool          `tfsdk:"not_nullable"`
+	ForeignKey  *ConnectionForeignKey `tfsdk:"foreign_key"`
+}
+
+type ConnectionForeignKey struct {
+	Schema  types.String   `tfsdk:"schema"`
+	Table   types.String   `tfsdk:"table"`
+	Columns []types.String `tfsdk:"columns"`
+}
+
+type ConnectionPrimaryKeyConstraint struct {
+	Schema  types.String   `tfsdk:"schema"`
+	Table   types.String   `tfsdk:"table"`
+	Columns []types.String `tfsdk:"columns"`
+}
+
+func (d *ConnectionForeignKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_foreign_keys"
+}
+
+func (d *ConnectionForeignKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Neosync Connection foreign key data source. Returns the foreign key and primary key constraints that exist in the database behind a SQL connection",
+
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Description: "The unique identifier of the connection",
+				Required:    true,
+			},
+			"foreign_keys": schema.ListNestedAttribute{
+				Description: "The foreign key constraints found in the connection. Has the same shape as the virtual_foreign_keys on a job",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schema": schema.StringAttribute{
+							Description: "The database schema",
+							Computed:    true,
+						},
+						"table": schema.StringAttribute{
+							Description: "The database table",
+							Computed:    true,
+						},
+						"columns": schema.ListAttribute{
+							Description: "The columns in the table that make up the constraint",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"not_nullable": schema.ListAttribute{
+							Description: "Whether or not each of the constraint columns is not nullable. Ordered the same as columns",
+							Computed:    true,
+							ElementType: types.BoolType,
+						},
+						"foreign_key": schema.SingleNestedAttribute{
+							Description: "The table and columns that are referenced by the constraint",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"schema": schema.StringAttribute{
+									Description: "The database schema",
+									Computed:    true,
+								},
+								"table": schema.StringAttribute{
+									Description: "The database table",
+									Computed:    true,
+								},
+								"columns": schema.ListAttribute{
+									Description: "The referenced columns",
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+							},
+						},
+					},
+				},
+			},
+			"primary_keys": schema.ListNestedAttribute{
+				Description: "The primary key constraints found in the connection",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schema": schema.StringAttribute{
+							Description: "The database schema",
+							Computed:    true,
+						},
+						"table": schema.StringAttribute{
+							Description: "The database table",
+							Computed:    true,
+						},
+						"columns": schema.ListAttribute{
+							Description: "The columns that make up the primary key",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectionForeignKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ConfigData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ConfigData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.ConnectionDataClient
+}
+
+func (d *ConnectionForeignKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConnectionForeignKeysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	constraintsResp, err := d.client.GetConnectionTableConstraints(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionTableConstraintsRequest{
+		ConnectionId: data.ConnectionId.ValueString(),
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get connection constraints", err.Error())
+		return
+	}
+
+	data.ForeignKeys = fromForeignKeyConstraintsDto(constraintsResp.Msg.GetForeignKeyConstraints())
+	data.PrimaryKeys = fromPrimaryKeyConstraintsDto(constraintsResp.Msg.GetPrimaryKeyConstraints())
+
+	tflog.Trace(ctx, "read connection constraints", map[string]any{"connection_id": data.ConnectionId.ValueString()})
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func fromForeignKeyConstraintsDto(dto map[string]*mgmtv1alpha1.ForeignConstraintTables) []*ConnectionForeignKeyConstraint {
+	output := []*ConnectionForeignKeyConstraint{}
+	for _, key := range sortedKeys(dto) {
+		schemaName, tableName := splitSchemaTable(key)
+		for _, constraint := range dto[key].GetConstraints() {
+			notNullable := make([]types.Bool, 0, len(constraint.GetNotNullable()))
+			for _, nn := range constraint.GetNotNullable() {
+				notNullable = append(notNullable, types.BoolValue(nn))
+			}
+			fkSchema, fkTable := splitSchemaTable(constraint.GetForeignKey().GetTable())
+			output = append(output, &ConnectionForeignKeyConstraint{
+				Schema:      types.StringValue(schemaName),
+				Table:       types.StringValue(tableName),
+				Columns:     toStringValues(constraint.GetColumns()),
+				NotNullable: notNullable,
+				ForeignKey: &ConnectionForeignKey{
+					Schema:  types.StringValue(fkSchema),
+					Table:   types.StringValue(fkTable),
+					Columns: toStringValues(constraint.GetForeignKey().GetColumns()),
+				},
+			})
+		}
+	}
+	return output
+}
+
+func fromPrimaryKeyConstraintsDto(dto map[string]*mgmtv1alpha1.PrimaryConstraint) []*ConnectionPrimaryKeyConstraint {
+	output := make([]*ConnectionPrimaryKeyConstraint, 0, len(dto))
+	for _, key := range sortedKeys(dto) {
+		schemaName, tableName := splitSchemaTable(key)
+		output = append(output, &ConnectionPrimaryKeyConstraint{
+			Schema:  types.StringValue(schemaName),
+			Table:   types.StringValue(tableName),
+			Columns: toStringValues(dto[key].GetColumns()),
+		})
+	}
+	return output
+}
+
+// Splits a <schema>.<table> key as returned by the connection data service.
+func splitSchemaTable(key string) (schemaName, tableName string) {
+	schemaName, tableName, found := strings.Cut(key, ".")
+	if !found {
+		return "", key
+	}
+	return schemaName, tableName
+}
+
+func sortedKeys[V any](input map[string]V) []string {
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func toStringValues(input []string) []types.String {
+	output := make([]types.String, 0, len(input))
+	for _, value := range input {
+		output = append(output, types.StringValue(value))
+	}
+	return output
+}
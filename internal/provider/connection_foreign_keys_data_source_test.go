@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_ConnectionForeignKeys_DataSource_Unreachable(t *testing.T) {
+	connectionName := acctest.RandString(10)
+	config := fmt.Sprintf(`
+resource "neosync_connection" "test" {
+  name = "%s"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+data "neosync_connection_foreign_keys" "test" {
+  connection_id = neosync_connection.test.id
+}
+`, connectionName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("Unable to get connection constraints"),
+			},
+		},
+	})
+}
+
+func Test_fromForeignKeyConstraintsDto(t *testing.T) {
+	dto := map[string]*mgmtv1alpha1.ForeignConstraintTables{
+		"public.orders": {Constraints: []*mgmtv1alpha1.ForeignConstraint{
+			{
+				Columns:     []string{"user_id", "account_id"},
+				NotNullable: []bool{true, false},
+				ForeignKey:  &mgmtv1alpha1.ForeignKey{Table: "public.users", Columns: []string{"id", "account_id"}},
+			},
+		}},
+		"billing.invoices": {Constraints: []*mgmtv1alpha1.ForeignConstraint{
+			{
+				Columns:     []string{"order_id"},
+				NotNullable: []bool{true},
+				ForeignKey:  &mgmtv1alpha1.ForeignKey{Table: "public.orders", Columns: []string{"id"}},
+			},
+		}},
+	}
+
+	actual := fromForeignKeyConstraintsDto(dto)
+	require.Equal(t, []*ConnectionForeignKeyConstraint{
+		{
+			Schema:      types.StringValue("billing"),
+			Table:       types.StringValue("invoices"),
+			Columns:     []types.String{types.StringValue("order_id")},
+			NotNullable: []types.Bool{types.BoolValue(true)},
+			ForeignKey: &ConnectionForeignKey{
+				Schema:  types.StringValue("public"),
+				Table:   types.StringValue("orders"),
+				Columns: []types.String{types.StringValue("id")},
+			},
+		},
+		{
+			Schema:      types.StringValue("public"),
+			Table:       types.StringValue("orders"),
+			Columns:     []types.String{types.StringValue("user_id"), types.StringValue("account_id")},
+			NotNullable: []types.Bool{types.BoolValue(true), types.BoolValue(false)},
+			ForeignKey: &ConnectionForeignKey{
+				Schema:  types.StringValue("public"),
+				Table:   types.StringValue("users"),
+				Columns: []types.String{types.StringValue("id"), types.StringValue("account_id")},
+			},
+		},
+	}, actual)
+
+	require.Empty(t, fromForeignKeyConstraintsDto(nil))
+	require.NotNil(t, fromForeignKeyConstraintsDto(nil))
+}
+
+func Test_fromPrimaryKeyConstraintsDto(t *testing.T) {
+	dto := map[string]*mgmtv1alpha1.PrimaryConstraint{
+		"public.users":       {Columns: []string{"id"}},
+		"public.memberships": {Columns: []string{"user_id", "team_id"}},
+		"accounts":           {Columns: []string{"id"}},
+	}
+
+	actual := fromPrimaryKeyConstraintsDto(dto)
+	require.Equal(t, []*ConnectionPrimaryKeyConstraint{
+		{Schema: types.StringValue(""), Table: types.StringValue("accounts"), Columns: []types.String{types.StringValue("id")}},
+		{Schema: types.StringValue("public"), Table: types.StringValue("memberships"), Columns: []types.String{types.StringValue("user_id"), types.StringValue("team_id")}},
+		{Schema: types.StringValue("public"), Table: types.StringValue("users"), Columns: []types.String{types.StringValue("id")}},
+	}, actual)
+}
+
+func Test_splitSchemaTable(t *testing.T) {
+	testcases := []struct {
+		name   string
+		key    string
+		schema string
+		table  string
+	}{
+		{"schema and table", "public.users", "public", "users"},
+		{"no schema", "users", "", "users"},
+		{"dotted table", "public.users.archive", "public", "users.archive"},
+		{"empty", "", "", ""},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			schemaName, tableName := splitSchemaTable(tc.key)
+			require.Equal(t, tc.schema, schemaName)
+			require.Equal(t, tc.table, tableName)
+		})
+	}
+}
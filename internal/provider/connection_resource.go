@@ -4,21 +4,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/terraform-provider-neosync/internal/models"
 	connection_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/connections"
 )
 
 var _ resource.Resource = &ConnectionResource{}
 var _ resource.ResourceWithImportState = &ConnectionResource{}
+var _ resource.ResourceWithConfigValidators = &ConnectionResource{}
 
 func NewConnectionResource() resource.Resource {
 	return &ConnectionResource{}
@@ -26,6 +35,7 @@ func NewConnectionResource() resource.Resource {
 
 type ConnectionResource struct {
 	client    mgmtv1alpha1connect.ConnectionServiceClient
+	jobclient mgmtv1alpha1connect.JobServiceClient
 	accountId *string
 }
 
@@ -98,18 +108,22 @@ var (
 			"max_idle_connections": schema.Int32Attribute{
 				Description: "The maximum number of idle connections to the database",
 				Optional:    true,
+				Validators:  []validator.Int32{int32validator.AtLeast(0)},
 			},
 			"max_open_connections": schema.Int32Attribute{
 				Description: "The maximum number of open connections to the database",
 				Optional:    true,
+				Validators:  []validator.Int32{int32validator.AtLeast(0)},
 			},
 			"max_idle_duration": schema.StringAttribute{
-				Description: "The maximum amount of time a connection may be reused",
+				Description: "The maximum amount of time a connection may be reused. Must be a Go duration string, such as \"5m\" or \"1h30m\"",
 				Optional:    true,
+				CustomType:  models.DurationType{},
 			},
 			"max_open_duration": schema.StringAttribute{
-				Description: "The maximum amount of time a connection may be idle",
+				Description: "The maximum amount of time a connection may be idle. Must be a Go duration string, such as \"5m\" or \"1h30m\"",
 				Optional:    true,
+				CustomType:  models.DurationType{},
 			},
 		},
 	}
@@ -131,6 +145,18 @@ func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured()},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether or not Terraform is prevented from deleting the connection. It must be set to false and applied before the connection can be destroyed",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "By default, the connection will not be deleted while it is still used by a job or job hook. Set to true to delete it anyway",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"postgres": schema.SingleNestedAttribute{
 				Description: "The postgres database that will be associated with this connection",
 				Optional:    true,
@@ -139,11 +165,30 @@ func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"url": schema.StringAttribute{
 						Description: "Standard postgres url connection string. Must be uri compliant",
 						Optional:    true,
+						CustomType:  connection_model.ConnectionUrlType{},
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRoot("postgres").AtName("url"), path.MatchRoot("postgres").AtName("host")),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("postgres").AtName("port"),
+								path.MatchRoot("postgres").AtName("name"),
+								path.MatchRoot("postgres").AtName("user"),
+								path.MatchRoot("postgres").AtName("pass"),
+								path.MatchRoot("postgres").AtName("ssl_mode"),
+							),
+						},
 					},
 
 					"host": schema.StringAttribute{
 						Description: "The host name of the postgres server",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("postgres").AtName("port"),
+								path.MatchRoot("postgres").AtName("name"),
+								path.MatchRoot("postgres").AtName("user"),
+								path.MatchRoot("postgres").AtName("pass"),
+							),
+						},
 					},
 					"port": schema.Int64Attribute{
 						Description: "The port of the postgres server",
@@ -180,11 +225,31 @@ func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"url": schema.StringAttribute{
 						Description: "Standard mysql url connection string.",
 						Optional:    true,
+						CustomType:  connection_model.ConnectionUrlType{},
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRoot("mysql").AtName("url"), path.MatchRoot("mysql").AtName("host")),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("mysql").AtName("port"),
+								path.MatchRoot("mysql").AtName("name"),
+								path.MatchRoot("mysql").AtName("user"),
+								path.MatchRoot("mysql").AtName("pass"),
+								path.MatchRoot("mysql").AtName("protocol"),
+							),
+						},
 					},
 
 					"host": schema.StringAttribute{
 						Description: "The host name of the mysql server",
 						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("mysql").AtName("port"),
+								path.MatchRoot("mysql").AtName("name"),
+								path.MatchRoot("mysql").AtName("user"),
+								path.MatchRoot("mysql").AtName("pass"),
+								path.MatchRoot("mysql").AtName("protocol"),
+							),
+						},
 					},
 					"port": schema.Int64Attribute{
 						Description: "The port of the mysql server",
@@ -233,7 +298,7 @@ func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 						Optional:    true,
 					},
 					"credentials": schema.SingleNestedAttribute{
-						Description: "Credentials that may be necessary to access the S3 bucket in a R/W fashion",
+						Description: "Credentials that may be necessary to access the S3 bucket in a R/W fashion. Web identity (e.g. EKS IRSA) is not configurable here; omit this block to have the worker resolve credentials from its default AWS credential chain, which picks up AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE",
 						Optional:    true,
 						Attributes: map[string]schema.Attribute{
 							"profile": schema.StringAttribute{
@@ -282,6 +347,16 @@ func (r *ConnectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+func (r *ConnectionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("postgres"),
+			path.MatchRoot("mysql"),
+			path.MatchRoot("aws_s3"),
+		),
+	}
+}
+
 func (r *ConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -300,6 +375,7 @@ func (r *ConnectionResource) Configure(ctx context.Context, req resource.Configu
 	}
 
 	r.client = providerData.ConnectionClient
+	r.jobclient = providerData.JobClient
 	r.accountId = providerData.AccountId
 }
 
@@ -339,6 +415,8 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("connection model hydration error", err.Error())
 		return
 	}
+	newModel.ForceDestroy = data.ForceDestroy
+	newModel.DeletionProtection = data.DeletionProtection
 	tflog.Trace(ctx, "mapped connection to model during creation")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
 }
@@ -369,6 +447,8 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		resp.Diagnostics.AddError("connection model hydration error", err.Error())
 		return
 	}
+	newModel.ForceDestroy = data.ForceDestroy
+	newModel.DeletionProtection = data.DeletionProtection
 	tflog.Trace(ctx, "mapped connection to model during read")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
@@ -404,6 +484,8 @@ func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		resp.Diagnostics.AddError("connection model hydration error", err.Error())
 		return
 	}
+	newModel.ForceDestroy = data.ForceDestroy
+	newModel.DeletionProtection = data.DeletionProtection
 	tflog.Trace(ctx, "mapped connection to model during update")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
@@ -417,6 +499,29 @@ func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Connection is protected from deletion",
+			fmt.Sprintf("connection %q has deletion_protection enabled. Set deletion_protection = false and apply before destroying it", data.Name.ValueString()),
+		)
+		return
+	}
+
+	if !data.ForceDestroy.ValueBool() {
+		reference, err := getConnectionReference(ctx, r.jobclient, data.AccountId.ValueString(), data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to check connection references", err.Error())
+			return
+		}
+		if reference != "" {
+			resp.Diagnostics.AddError(
+				"Connection is still in use",
+				fmt.Sprintf("connection %q is referenced by %s. Remove its references or set force_destroy = true to delete it anyway", data.Name.ValueString(), reference),
+			)
+			return
+		}
+	}
+
 	_, err := r.client.DeleteConnection(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteConnectionRequest{
 		Id: data.Id.ValueString(),
 	}))
@@ -430,28 +535,43 @@ func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 func (r *ConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	if req.ID == "" {
-		resp.Diagnostics.AddError("Unable to import", "must provide ID")
+		resp.Diagnostics.AddError("Unable to import", "must provide ID or name:<name>")
 		return
 	}
 
-	connResp, err := r.client.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
-		Id: req.ID,
-	}))
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to get connection", err.Error())
-		return
+	var connection *mgmtv1alpha1.Connection
+	if name, ok := parseImportName(req.ID); ok {
+		if r.accountId == nil {
+			resp.Diagnostics.AddError("Unable to import", "must configure the provider with an account id to import by name")
+			return
+		}
+		namedConnection, err := getConnectionByName(ctx, r.client, *r.accountId, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get connection by name", err.Error())
+			return
+		}
+		connection = namedConnection
+	} else {
+		connResp, err := r.client.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: req.ID,
+		}))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get connection", err.Error())
+			return
+		}
+		connection = connResp.Msg.GetConnection()
 	}
 	tflog.Trace(ctx, "retrieved connection during import")
 
-	connection := connResp.Msg.GetConnection()
-
 	var data connection_model.ConnectionResourceModel
-	err = data.FromDto(connection)
+	err := data.FromDto(connection)
 	if err != nil {
 		resp.Diagnostics.AddError("connection config hydration error", err.Error())
 		return
 	}
 
+	data.ForceDestroy = types.BoolValue(false)
+	data.DeletionProtection = types.BoolValue(false)
 	tflog.Trace(ctx, "mapped connection to model during import")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -470,3 +590,62 @@ func (r *ConnectionResource) getAccountId(data *connection_model.ConnectionResou
 	}
 	return accountId, nil
 }
+
+// Returns a description of the first job or job hook in the account that uses the given connection,
+// or an empty string if nothing uses it. Jobs are checked first as they come back from a single GetJobs call.
+// The job service can only list hooks per job, so hooks are only fetched once no job references the connection,
+// and stop being fetched as soon as a referencing hook is found.
+// It only runs when deleting a connection without force_destroy.
+func getConnectionReference(
+	ctx context.Context,
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	accountId string,
+	connectionId string,
+) (string, error) {
+	jobsResp, err := jobclient.GetJobs(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobsRequest{
+		AccountId: accountId,
+	}))
+	if err != nil {
+		return "", err
+	}
+
+	for _, job := range jobsResp.Msg.GetJobs() {
+		if slices.Contains(getJobConnectionIds(job), connectionId) {
+			return fmt.Sprintf("job %q (%s)", job.GetName(), job.GetId()), nil
+		}
+	}
+
+	for _, job := range jobsResp.Msg.GetJobs() {
+		hooksResp, err := jobclient.GetJobHooks(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobHooksRequest{
+			JobId: job.GetId(),
+		}))
+		if err != nil {
+			return "", err
+		}
+		for _, hook := range hooksResp.Msg.GetHooks() {
+			if hook.GetConfig().GetSql().GetConnectionId() == connectionId {
+				return fmt.Sprintf("job hook %q (%s) on job %q", hook.GetName(), hook.GetId(), job.GetName()), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func getJobConnectionIds(job *mgmtv1alpha1.Job) []string {
+	options := job.GetSource().GetOptions()
+	connectionIds := []string{
+		options.GetPostgres().GetConnectionId(),
+		options.GetMysql().GetConnectionId(),
+		options.GetMssql().GetConnectionId(),
+		options.GetAwsS3().GetConnectionId(),
+		options.GetMongodb().GetConnectionId(),
+		options.GetDynamodb().GetConnectionId(),
+		options.GetGenerate().GetFkSourceConnectionId(),
+		options.GetAiGenerate().GetAiConnectionId(),
+		options.GetAiGenerate().GetFkSourceConnectionId(),
+	}
+	for _, destination := range job.GetDestinations() {
+		connectionIds = append(connectionIds, destination.GetConnectionId())
+	}
+	return connectionIds
+}
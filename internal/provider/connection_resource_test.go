@@ -1,12 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"connectrpc.com/connect"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAcc_Connection_Postgres_Url(t *testing.T) {
@@ -43,6 +49,7 @@ resource "neosync_connection" "test1" {
 					resource.TestCheckResourceAttrSet("neosync_connection.test1", "id"),
 					resource.TestCheckResourceAttr("neosync_connection.test1", "name", connectionName),
 					resource.TestCheckResourceAttr("neosync_connection.test1", "postgres.url", "test-url"),
+					resource.TestCheckResourceAttr("neosync_connection.test1", "force_destroy", "false"),
 					GetAccountIdFromState("neosync_connection.test1", func(accountId string) { accountID = accountId }),
 				),
 			},
@@ -360,6 +367,173 @@ resource "neosync_connection" "test1" {
 	})
 }
 
+func TestAcc_Connection_Postgres_Connection_InvalidConnectionOptions(t *testing.T) {
+	connectionName := acctest.RandString(10)
+
+	testAccConnectionConfig := fmt.Sprintf(`
+resource "neosync_connection" "test1" {
+  name = "%s"
+
+	postgres = {
+		url = "test-url"
+
+		connection_options = {
+			max_open_connections = -1
+			max_idle_duration = "5 minutes"
+		}
+	}
+}
+`, connectionName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectionConfig,
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+		},
+	})
+}
+
+func TestAcc_Connection_DeletionProtection(t *testing.T) {
+	connectionName := acctest.RandString(10)
+
+	testAccConnectionConfig := fmt.Sprintf(`
+resource "neosync_connection" "test1" {
+  name = "%s"
+  deletion_protection = true
+
+	postgres = {
+		url = "test-url"
+	}
+}
+`, connectionName)
+	testAccConnectionConfigUnprotected := fmt.Sprintf(`
+resource "neosync_connection" "test1" {
+  name = "%s"
+  deletion_protection = false
+
+	postgres = {
+		url = "test-url"
+	}
+}
+`, connectionName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_connection.test1", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccConnectionConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Connection is protected from deletion"),
+			},
+			{
+				Config: testAccConnectionConfigUnprotected,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_connection.test1", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Connection_InUse(t *testing.T) {
+	name := acctest.RandString(10)
+
+	// The job looks the destination up by name so that the destination can depend on the job,
+	// which makes terraform destroy the destination while the job still references it.
+	getConfig := func(destinationDependsOn, jobDependsOn string) string {
+		return fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+	depends_on = [%s]
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+data "neosync_connection" "destination" {
+	name = "%s-dest"
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	depends_on = [%s]
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = data.neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+			}
+		}
+	}
+}
+	`, name, name, destinationDependsOn, name, name, jobDependsOn)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+`, name, name),
+			},
+			{
+				Config: getConfig("neosync_job.job1", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_connection.destination", "force_destroy", "false"),
+				),
+			},
+			{
+				Config:      getConfig("neosync_job.job1", ""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Connection is still in use"),
+			},
+			{
+				// restores the usual destroy order so that the job is destroyed before the destination
+				Config: getConfig("", "neosync_connection.destination"),
+			},
+		},
+	})
+}
+
 func TestAcc_Connection_Import(t *testing.T) {
 	connectionName := acctest.RandString(10)
 	testAccConnectionConfig := fmt.Sprintf(`
@@ -406,6 +580,43 @@ resource "neosync_connection" "test1" {
 	})
 }
 
+func TestAcc_Connection_Import_ByName(t *testing.T) {
+	connectionName := acctest.RandString(10)
+	testAccConnectionConfig := fmt.Sprintf(`
+resource "neosync_connection" "test1" {
+  name = "%s"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+`, connectionName)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("neosync_connection.test1", "id"),
+				),
+			},
+			{
+				ResourceName:      "neosync_connection.test1",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "name:" + connectionName,
+			},
+			{
+				ResourceName:  "neosync_connection.test1",
+				ImportState:   true,
+				ImportStateId: "name:" + connectionName + "-missing",
+				ExpectError:   regexp.MustCompile("Unable to get connection by name"),
+			},
+		},
+	})
+}
+
 func TestAcc_Connection_Mysql_Url(t *testing.T) {
 	connectionName := acctest.RandString(10)
 
@@ -644,6 +855,60 @@ resource "neosync_connection" "test1" {
 	})
 }
 
+func TestAcc_Connection_Postgres_UrlAndHost(t *testing.T) {
+	connectionName := acctest.RandString(10)
+
+	testAccConnectionConfig := fmt.Sprintf(`
+resource "neosync_connection" "test1" {
+  name = "%s"
+
+	postgres = {
+		url = "test-url"
+		host = "test-host"
+	}
+}
+`, connectionName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectionConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestAcc_Connection_MultipleConfigs(t *testing.T) {
+	connectionName := acctest.RandString(10)
+
+	testAccConnectionConfig := fmt.Sprintf(`
+resource "neosync_connection" "test1" {
+  name = "%s"
+
+	postgres = {
+		url = "test-url"
+	}
+	mysql = {
+		url = "test-url"
+	}
+}
+`, connectionName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectionConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
 func getPrimaryImportId(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[n]
@@ -658,3 +923,76 @@ func getPrimaryImportId(n string) resource.ImportStateIdFunc {
 		return rs.Primary.ID, nil
 	}
 }
+
+// Serves a fixed set of jobs and hooks, and records which jobs had their hooks listed
+type fakeReferenceJobServiceClient struct {
+	mgmtv1alpha1connect.JobServiceClient
+
+	jobs       []*mgmtv1alpha1.Job
+	hooks      map[string][]*mgmtv1alpha1.JobHook
+	hookJobIds []string
+}
+
+func (f *fakeReferenceJobServiceClient) GetJobs(ctx context.Context, req *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
+	return connect.NewResponse(&mgmtv1alpha1.GetJobsResponse{Jobs: f.jobs}), nil
+}
+
+func (f *fakeReferenceJobServiceClient) GetJobHooks(ctx context.Context, req *connect.Request[mgmtv1alpha1.GetJobHooksRequest]) (*connect.Response[mgmtv1alpha1.GetJobHooksResponse], error) {
+	f.hookJobIds = append(f.hookJobIds, req.Msg.GetJobId())
+	return connect.NewResponse(&mgmtv1alpha1.GetJobHooksResponse{Hooks: f.hooks[req.Msg.GetJobId()]}), nil
+}
+
+func Test_getConnectionReference(t *testing.T) {
+	newJob := func(id, destinationConnectionId string) *mgmtv1alpha1.Job {
+		return &mgmtv1alpha1.Job{
+			Id:   id,
+			Name: "job-" + id,
+			Source: &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Postgres{Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: "source"}},
+			}},
+			Destinations: []*mgmtv1alpha1.JobDestination{{ConnectionId: destinationConnectionId}},
+		}
+	}
+	newSqlHook := func(id, connectionId string) *mgmtv1alpha1.JobHook {
+		return &mgmtv1alpha1.JobHook{
+			Id:   id,
+			Name: "hook-" + id,
+			Config: &mgmtv1alpha1.JobHookConfig{Config: &mgmtv1alpha1.JobHookConfig_Sql{
+				Sql: &mgmtv1alpha1.JobHookConfig_JobSqlHook{ConnectionId: connectionId},
+			}},
+		}
+	}
+
+	t.Run("job reference does not list hooks", func(t *testing.T) {
+		client := &fakeReferenceJobServiceClient{jobs: []*mgmtv1alpha1.Job{newJob("1", "other"), newJob("2", "conn-1")}}
+
+		reference, err := getConnectionReference(context.Background(), client, "account-1", "conn-1")
+		require.NoError(t, err)
+		require.Equal(t, `job "job-2" (2)`, reference)
+		require.Empty(t, client.hookJobIds)
+	})
+
+	t.Run("stops at the first hook reference", func(t *testing.T) {
+		client := &fakeReferenceJobServiceClient{
+			jobs: []*mgmtv1alpha1.Job{newJob("1", "other"), newJob("2", "other"), newJob("3", "other")},
+			hooks: map[string][]*mgmtv1alpha1.JobHook{
+				"2": {newSqlHook("a", "conn-1")},
+				"3": {newSqlHook("b", "conn-1")},
+			},
+		}
+
+		reference, err := getConnectionReference(context.Background(), client, "account-1", "conn-1")
+		require.NoError(t, err)
+		require.Equal(t, `job hook "hook-a" (a) on job "job-2"`, reference)
+		require.Equal(t, []string{"1", "2"}, client.hookJobIds)
+	})
+
+	t.Run("unreferenced", func(t *testing.T) {
+		client := &fakeReferenceJobServiceClient{jobs: []*mgmtv1alpha1.Job{newJob("1", "other")}}
+
+		reference, err := getConnectionReference(context.Background(), client, "account-1", "conn-1")
+		require.NoError(t, err)
+		require.Empty(t, reference)
+		require.Equal(t, []string{"1"}, client.hookJobIds)
+	})
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	connection_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/connections"
+)
+
+var _ datasource.DataSource = &ConnectionsDataSource{}
+
+func NewConnectionsDataSource() datasource.DataSource {
+	return &ConnectionsDataSource{}
+}
+
+type ConnectionsDataSource struct {
+	client    mgmtv1alpha1connect.ConnectionServiceClient
+	accountId *string
+}
+
+type ConnectionsDataSourceModel struct {
+	AccountId   types.String                   `tfsdk:"account_id"`
+	NameRegex   types.String                   `tfsdk:"name_regex"`
+	Type        types.String                   `tfsdk:"type"`
+	Connections []*ConnectionsDataSourceResult `tfsdk:"connections"`
+}
+
+type ConnectionsDataSourceResult struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *ConnectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connections"
+}
+
+func (d *ConnectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Neosync Connections data source. Lists the connections in an account with optional filtering",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Description: "The unique identifier of the account. Can be pulled from the API Key if present, or must be specified if using a user access token",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "A regular expression that the connection name must match to be returned",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return connections of this type. One of: %s", strings.Join(connection_model.ConnectionTypes, ", ")),
+				Optional:    true,
+			},
+			"connections": schema.ListNestedAttribute{
+				Description: "The connections that matched the filters, sorted by name",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the connection",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The unique name of the connection",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the connection",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the connection was created, in RFC3339 format",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "When the connection was last updated, in RFC3339 format",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ConfigData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ConfigData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.ConnectionClient
+	d.accountId = providerData.AccountId
+}
+
+func (d *ConnectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConnectionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if data.NameRegex.ValueString() != "" {
+		compiled, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name regex", err.Error())
+			return
+		}
+		nameRegex = compiled
+	}
+	if data.Type.ValueString() != "" && !slices.Contains(connection_model.ConnectionTypes, data.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid connection type",
+			fmt.Sprintf("%q is not a known connection type. Must be one of: %s", data.Type.ValueString(), strings.Join(connection_model.ConnectionTypes, ", ")),
+		)
+		return
+	}
+
+	accountId, err := d.getAccountId(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("no account id", err.Error())
+		return
+	}
+	data.AccountId = types.StringValue(accountId)
+
+	connsResp, err := d.client.GetConnections(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionsRequest{
+		AccountId: accountId,
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get connections", err.Error())
+		return
+	}
+
+	connections := []*ConnectionsDataSourceResult{}
+	for _, connection := range connsResp.Msg.GetConnections() {
+		if nameRegex != nil && !nameRegex.MatchString(connection.GetName()) {
+			continue
+		}
+		connectionType := connection_model.GetConnectionType(connection.GetConnectionConfig())
+		if data.Type.ValueString() != "" && data.Type.ValueString() != connectionType {
+			continue
+		}
+		connections = append(connections, &ConnectionsDataSourceResult{
+			Id:        types.StringValue(connection.GetId()),
+			Name:      types.StringValue(connection.GetName()),
+			Type:      types.StringValue(connectionType),
+			CreatedAt: timestampToStringValue(connection.GetCreatedAt()),
+			UpdatedAt: timestampToStringValue(connection.GetUpdatedAt()),
+		})
+	}
+	slices.SortFunc(connections, func(a, b *ConnectionsDataSourceResult) int {
+		return strings.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+	data.Connections = connections
+
+	tflog.Trace(ctx, "read connections", map[string]any{"count": len(connections)})
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ConnectionsDataSource) getAccountId(data *ConnectionsDataSourceModel) (string, error) {
+	var accountId string
+	if data.AccountId.ValueString() == "" {
+		if d.accountId != nil {
+			accountId = *d.accountId
+		}
+	} else {
+		accountId = data.AccountId.ValueString()
+	}
+	if accountId == "" {
+		return "", errors.New("must provide account id either on the data source or provide through environment configuration")
+	}
+	return accountId, nil
+}
+
+func timestampToStringValue(ts *timestamppb.Timestamp) types.String {
+	if ts == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(ts.AsTime().UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_Connections_DataSource(t *testing.T) {
+	prefix := acctest.RandString(10)
+	config := fmt.Sprintf(`
+resource "neosync_connection" "pg" {
+  name = "%s-pg"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "mysql" {
+  name = "%s-mysql"
+
+	mysql = {
+		url = "test-url"
+	}
+}
+data "neosync_connections" "all" {
+  name_regex = "^%s-"
+
+  depends_on = [neosync_connection.pg, neosync_connection.mysql]
+}
+data "neosync_connections" "pg" {
+  name_regex = "^%s-"
+  type       = "postgres"
+
+  depends_on = [neosync_connection.pg, neosync_connection.mysql]
+}
+`, prefix, prefix, prefix, prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.neosync_connections.all", "connections.#", "2"),
+					resource.TestCheckResourceAttr("data.neosync_connections.all", "connections.0.name", prefix+"-mysql"),
+					resource.TestCheckResourceAttr("data.neosync_connections.all", "connections.0.type", "mysql"),
+					resource.TestCheckResourceAttr("data.neosync_connections.all", "connections.1.name", prefix+"-pg"),
+					resource.TestCheckResourceAttrSet("data.neosync_connections.all", "connections.1.created_at"),
+					resource.TestCheckResourceAttr("data.neosync_connections.pg", "connections.#", "1"),
+					resource.TestCheckResourceAttrPair("data.neosync_connections.pg", "connections.0.id", "neosync_connection.pg", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+const importNamePrefix = "name:"
+
+// Resources that support import accept either the raw unique identifier
+// or name:<name>, which will be resolved within the provider's account.
+// Returns the name and true if the import ID is in the name:<name> form.
+func parseImportName(importId string) (string, bool) {
+	name, found := strings.CutPrefix(importId, importNamePrefix)
+	if !found {
+		return "", false
+	}
+	return name, true
+}
+
+type namedDto interface {
+	GetName() string
+}
+
+// Finds the only item with the given name, where kind describes the items in the errors.
+// Names are not unique within an account, so this returns an error if no item or more than one item has that name.
+func findOneByName[T namedDto](items []T, kind, accountId, name string) (T, error) {
+	var match T
+	count := 0
+	for _, item := range items {
+		if item.GetName() == name {
+			match = item
+			count++
+		}
+	}
+	switch count {
+	case 0:
+		return match, fmt.Errorf("no %s named %q was found in account %s", kind, name, accountId)
+	case 1:
+		return match, nil
+	default:
+		var zero T
+		return zero, fmt.Errorf("found %d %ss named %q in account %s, use the %s's id instead", count, kind, name, accountId, kind)
+	}
+}
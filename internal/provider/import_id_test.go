@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseImportName(t *testing.T) {
+	name, ok := parseImportName("name:prod-replica")
+	assert.True(t, ok)
+	assert.Equal(t, "prod-replica", name)
+
+	name, ok = parseImportName("name:with:colons")
+	assert.True(t, ok)
+	assert.Equal(t, "with:colons", name)
+
+	name, ok = parseImportName("3b83d1d3-5ffe-48c6-ac11-7a2e60802864")
+	assert.False(t, ok)
+	assert.Empty(t, name)
+}
+
+func Test_findOneByName(t *testing.T) {
+	jobs := []*mgmtv1alpha1.Job{
+		{Id: "1", Name: "nightly"},
+		{Id: "2", Name: "hourly"},
+		{Id: "3", Name: "hourly"},
+	}
+
+	job, err := findOneByName(jobs, "job", "account-1", "nightly")
+	require.NoError(t, err)
+	require.Equal(t, "1", job.GetId())
+
+	job, err = findOneByName(jobs, "job", "account-1", "weekly")
+	require.EqualError(t, err, `no job named "weekly" was found in account account-1`)
+	require.Nil(t, job)
+
+	job, err = findOneByName(jobs, "job", "account-1", "hourly")
+	require.EqualError(t, err, `found 2 jobs named "hourly" in account account-1, use the job's id instead`)
+	require.Nil(t, job)
+}
+
+type fakeNamedJobServiceClient struct {
+	mgmtv1alpha1connect.JobServiceClient
+
+	jobs []*mgmtv1alpha1.Job
+}
+
+func (f *fakeNamedJobServiceClient) GetJobs(ctx context.Context, req *connect.Request[mgmtv1alpha1.GetJobsRequest]) (*connect.Response[mgmtv1alpha1.GetJobsResponse], error) {
+	return connect.NewResponse(&mgmtv1alpha1.GetJobsResponse{Jobs: f.jobs}), nil
+}
+
+func Test_getJobByName(t *testing.T) {
+	client := &fakeNamedJobServiceClient{jobs: []*mgmtv1alpha1.Job{
+		{Id: "1", Name: "nightly"},
+		{Id: "2", Name: "hourly"},
+		{Id: "3", Name: "hourly"},
+	}}
+
+	job, err := getJobByName(context.Background(), client, "account-1", "nightly")
+	require.NoError(t, err)
+	require.Equal(t, "1", job.GetId())
+
+	_, err = getJobByName(context.Background(), client, "account-1", "weekly")
+	require.ErrorContains(t, err, `no job named "weekly"`)
+
+	_, err = getJobByName(context.Background(), client, "account-1", "hourly")
+	require.ErrorContains(t, err, `found 2 jobs named "hourly"`)
+}
+
+type fakeNamedTransformersServiceClient struct {
+	mgmtv1alpha1connect.TransformersServiceClient
+
+	transformers []*mgmtv1alpha1.UserDefinedTransformer
+}
+
+func (f *fakeNamedTransformersServiceClient) GetUserDefinedTransformers(ctx context.Context, req *connect.Request[mgmtv1alpha1.GetUserDefinedTransformersRequest]) (*connect.Response[mgmtv1alpha1.GetUserDefinedTransformersResponse], error) {
+	return connect.NewResponse(&mgmtv1alpha1.GetUserDefinedTransformersResponse{Transformers: f.transformers}), nil
+}
+
+func Test_getUserDefinedTransformerByName(t *testing.T) {
+	client := &fakeNamedTransformersServiceClient{transformers: []*mgmtv1alpha1.UserDefinedTransformer{
+		{Id: "1", Name: "hash-email"},
+		{Id: "2", Name: "mask"},
+		{Id: "3", Name: "mask"},
+	}}
+
+	transformer, err := getUserDefinedTransformerByName(context.Background(), client, "account-1", "hash-email")
+	require.NoError(t, err)
+	require.Equal(t, "1", transformer.GetId())
+
+	_, err = getUserDefinedTransformerByName(context.Background(), client, "account-1", "redact")
+	require.ErrorContains(t, err, `no user defined transformer named "redact"`)
+
+	_, err = getUserDefinedTransformerByName(context.Background(), client, "account-1", "mask")
+	require.ErrorContains(t, err, `found 2 user defined transformers named "mask"`)
+}
@@ -38,8 +38,8 @@ resource "neosync_job" "job1" {
 			]
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -49,7 +49,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -35,8 +35,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -46,7 +46,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
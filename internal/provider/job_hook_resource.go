@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
@@ -69,9 +70,10 @@ func (r *JobHookResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description: "The configuration for the hook itself",
 				Required:    true,
 				Attributes: map[string]schema.Attribute{
+					// sql is the only kind of hook today. Once there are more, this becomes optional and the kinds are validated as a oneof
 					"sql": schema.SingleNestedAttribute{
 						Description: "A hook that will execute SQL on the specified connection",
-						Optional:    true,
+						Required:    true,
 						Attributes: map[string]schema.Attribute{
 							"query": schema.StringAttribute{
 								Description: "The SQL query that will be invoked",
@@ -84,6 +86,7 @@ func (r *JobHookResource) Schema(ctx context.Context, req resource.SchemaRequest
 							"timing": schema.SingleNestedAttribute{
 								Description: "The timing of when in the run lifecycle the hook will be invoked",
 								Required:    true,
+								Validators:  []validator.Object{exactlyOneOfAttributes()},
 								Attributes: map[string]schema.Attribute{
 									"pre_sync": schema.SingleNestedAttribute{
 										Description: "Will run before the first table sync (also truncation and schema init, if enabled)",
@@ -35,8 +35,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -46,7 +46,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -120,8 +120,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -131,7 +131,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -187,8 +187,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -198,7 +198,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -290,8 +290,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -301,7 +301,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -395,8 +395,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -406,7 +406,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
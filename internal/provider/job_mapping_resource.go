@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
+)
+
+var _ resource.Resource = &JobMappingResource{}
+var _ resource.ResourceWithImportState = &JobMappingResource{}
+
+func NewJobMappingResource() resource.Resource {
+	return &JobMappingResource{}
+}
+
+type JobMappingResource struct {
+	client mgmtv1alpha1connect.JobServiceClient
+}
+
+func (r *JobMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_mapping"
+}
+
+func (r *JobMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Job mapping resource. Manages the transformer of a single column of a job, independently from the neosync_job resource. " +
+			"The job must have manage_mappings set to false so that it does not remove the mapping",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The unique identifier of the job mapping, in the form of <job_id>/<schema>/<table>/<column>. Any / or % within the schema, table or column is escaped as %2F or %25",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"job_id": schema.StringAttribute{
+				Description:   "The unique identifier of the job this mapping belongs to",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"schema": schema.StringAttribute{
+				Description:   "The database schema",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"table": schema.StringAttribute{
+				Description:   "The database table",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"column": schema.StringAttribute{
+				Description:   "The column in the specified table",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"transformer": schema.SingleNestedAttribute{
+				Description: "The transformer that will be performed on the column",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"config": transformerSchema,
+				},
+			},
+		},
+	}
+}
+
+func (r *JobMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ConfigData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ConfigData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.JobClient
+}
+
+func (r *JobMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data job_model.JobMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newModel, diags := r.upsert(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "created job mapping")
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *JobMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data job_model.JobMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobResp, err := r.client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: data.JobId.ValueString(),
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get job", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "got job")
+
+	mapping := job_model.FindJobMappingDto(jobResp.Msg.GetJob().GetMappings(), data.Schema.ValueString(), data.Table.ValueString(), data.Column.ValueString())
+	if mapping == nil {
+		tflog.Trace(ctx, "job mapping no longer exists, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newModel := job_model.JobMappingResourceModel{}
+	err = newModel.FromDto(data.JobId.ValueString(), mapping)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to convert dto to state", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "mapped job mapping to model")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
+}
+
+func (r *JobMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planModel job_model.JobMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newModel, diags := r.upsert(ctx, &planModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "updated job mapping")
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *JobMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data job_model.JobMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := updateJobMappings(ctx, r.client, data.JobId.ValueString(), func(mappings []*mgmtv1alpha1.JobMapping) []*mgmtv1alpha1.JobMapping {
+		return job_model.RemoveJobMappingDto(mappings, data.Schema.ValueString(), data.Table.ValueString(), data.Column.ValueString())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete job mapping", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "deleted job mapping")
+}
+
+func (r *JobMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	jobId, schemaName, table, column, err := job_model.ParseJobMappingId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to import", err.Error())
+		return
+	}
+
+	jobResp, err := r.client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: jobId,
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get job", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "retrieved job during import")
+
+	mapping := job_model.FindJobMappingDto(jobResp.Msg.GetJob().GetMappings(), schemaName, table, column)
+	if mapping == nil {
+		resp.Diagnostics.AddError("Unable to import", fmt.Sprintf("job %q does not have a mapping for %s.%s.%s", jobId, schemaName, table, column))
+		return
+	}
+
+	var data job_model.JobMappingResourceModel
+	err = data.FromDto(jobId, mapping)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to map job mapping to model", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "mapped job mapping to model during import")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Saves the mapping to the job, replacing any existing mapping for the same column, and returns the saved mapping
+func (r *JobMappingResource) upsert(ctx context.Context, data *job_model.JobMappingResourceModel) (*job_model.JobMappingResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	mappingDto, err := data.ToDto()
+	if err != nil {
+		diags.AddError("unable to create job mapping request", err.Error())
+		return nil, diags
+	}
+
+	job, err := updateJobMappings(ctx, r.client, data.JobId.ValueString(), func(mappings []*mgmtv1alpha1.JobMapping) []*mgmtv1alpha1.JobMapping {
+		return job_model.UpsertJobMappingDto(mappings, mappingDto)
+	})
+	if err != nil {
+		diags.AddError("Unable to update job mappings", err.Error())
+		return nil, diags
+	}
+
+	mapping := job_model.FindJobMappingDto(job.GetMappings(), data.Schema.ValueString(), data.Table.ValueString(), data.Column.ValueString())
+	if mapping == nil {
+		diags.AddError("Unable to update job mappings", "the mapping was not present on the job after it was saved")
+		return nil, diags
+	}
+
+	newModel := &job_model.JobMappingResourceModel{}
+	err = newModel.FromDto(data.JobId.ValueString(), mapping)
+	if err != nil {
+		diags.AddError("unable to convert dto to state", err.Error())
+		return nil, diags
+	}
+	return newModel, diags
+}
+
+// Every change to a job's mappings or subsets rewrites its full source connection,
+// so changes to the same job are serialized within the provider.
+var jobSourceLocks sync.Map
+
+func lockJobSource(jobId string) func() {
+	value, _ := jobSourceLocks.LoadOrStore(jobId, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// Applies the change to the job's current mappings and saves them along with the job's current source and virtual foreign keys.
+// Returns the updated job.
+func updateJobMappings(
+	ctx context.Context,
+	client mgmtv1alpha1connect.JobServiceClient,
+	jobId string,
+	change func(mappings []*mgmtv1alpha1.JobMapping) []*mgmtv1alpha1.JobMapping,
+) (*mgmtv1alpha1.Job, error) {
+	unlock := lockJobSource(jobId)
+	defer unlock()
+
+	jobResp, err := client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobId}))
+	if err != nil {
+		return nil, err
+	}
+	job := jobResp.Msg.GetJob()
+
+	updateResp, err := client.UpdateJobSourceConnection(ctx, connect.NewRequest(&mgmtv1alpha1.UpdateJobSourceConnectionRequest{
+		Id:                 jobId,
+		Source:             job.GetSource(),
+		Mappings:           change(job.GetMappings()),
+		VirtualForeignKeys: job.GetVirtualForeignKeys(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return updateResp.Msg.GetJob(), nil
+}
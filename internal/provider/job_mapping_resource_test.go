@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_JobMapping(t *testing.T) {
+	name := acctest.RandString(10)
+
+	getConfig := func(preserveDomain bool) string {
+		return fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	manage_mappings = false
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+			}
+		}
+	}
+}
+
+resource "neosync_job_mapping" "id" {
+	job_id = neosync_job.job1.id
+	schema = "public"
+	table = "users"
+	column = "id"
+	transformer = {
+		config = {
+			passthrough = {}
+		}
+	}
+}
+
+resource "neosync_job_mapping" "email" {
+	job_id = neosync_job.job1.id
+	schema = "public"
+	table = "users"
+	column = "email"
+	transformer = {
+		config = {
+			transform_email = {
+				preserve_domain = %t
+				preserve_length = false
+			}
+		}
+	}
+}
+	`, name, name, name, preserveDomain)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("neosync_job_mapping.id", "id"),
+					resource.TestCheckResourceAttrSet("neosync_job_mapping.email", "id"),
+					resource.TestCheckNoResourceAttr("neosync_job.job1", "mappings"),
+					resource.TestCheckResourceAttr("neosync_job_mapping.email", "transformer.config.transform_email.preserve_domain", "false"),
+				),
+			},
+			{
+				Config: getConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_job_mapping.email", "transformer.config.transform_email.preserve_domain", "true"),
+				),
+			},
+			{
+				ResourceName:      "neosync_job_mapping.email",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
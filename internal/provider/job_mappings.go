@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
+)
+
+var _ planmodifier.List = jobMappingsOrderInsensitiveModifier{}
+
+// Plans the prior state when the configured mappings only differ from it in their ordering.
+// Mappings are identified by their schema, table and column.
+func jobMappingsOrderInsensitive() planmodifier.List {
+	return jobMappingsOrderInsensitiveModifier{}
+}
+
+type jobMappingsOrderInsensitiveModifier struct{}
+
+func (m jobMappingsOrderInsensitiveModifier) Description(ctx context.Context) string {
+	return "Ignores changes to the ordering of mappings"
+}
+
+func (m jobMappingsOrderInsensitiveModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m jobMappingsOrderInsensitiveModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	planMappings, ok := keyJobMappings(req.PlanValue.Elements())
+	if !ok {
+		return
+	}
+	stateMappings, ok := keyJobMappings(req.StateValue.Elements())
+	if !ok || len(planMappings) != len(stateMappings) {
+		return
+	}
+	for key, planMapping := range planMappings {
+		stateMapping, ok := stateMappings[key]
+		if !ok || !planMapping.Equal(stateMapping) {
+			return
+		}
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// Keys each mapping by its schema, table and column.
+// Returns false if any of the keys are not yet known, or if a key is present more than once.
+func keyJobMappings(elements []attr.Value) (map[job_model.JobMappingKey]attr.Value, bool) {
+	keyed := make(map[job_model.JobMappingKey]attr.Value, len(elements))
+	for _, element := range elements {
+		key, ok := jobMappingKey(element)
+		if !ok {
+			return nil, false
+		}
+		if _, ok := keyed[key]; ok {
+			return nil, false
+		}
+		keyed[key] = element
+	}
+	return keyed, true
+}
+
+// Returns the schema, table and column key of a job mapping, or false if it is not yet known.
+func jobMappingKey(element attr.Value) (job_model.JobMappingKey, bool) {
+	mapping, ok := element.(types.Object)
+	if !ok || mapping.IsNull() || mapping.IsUnknown() {
+		return job_model.JobMappingKey{}, false
+	}
+
+	attributes := mapping.Attributes()
+	parts := make([]string, 0, 3)
+	for _, name := range []string{"schema", "table", "column"} {
+		value, ok := attributes[name].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			return job_model.JobMappingKey{}, false
+		}
+		parts = append(parts, value.ValueString())
+	}
+	return job_model.JobMappingKey{Schema: parts[0], Table: parts[1], Column: parts[2]}, true
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
+	transformer_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/transformers"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_jobMappingsOrderInsensitive(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&JobResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	mappingsType, ok := schemaResp.Schema.Attributes["mappings"].GetType().(types.ListType)
+	require.True(t, ok)
+
+	newList := func(mappings ...*job_model.JobMapping) types.List {
+		list, diags := types.ListValueFrom(ctx, mappingsType.ElemType, mappings)
+		require.False(t, diags.HasError(), diags)
+		return list
+	}
+
+	id := newTestJobMapping("id")
+	email := newTestJobMapping("email")
+	hashedEmail := newTestJobMapping("email")
+	hashedEmail.Transformer = &transformer_model.Transformer{
+		Config: &transformer_model.TransformerConfig{TransformEmail: &transformer_model.TransformEmail{PreserveDomain: types.BoolValue(true)}},
+	}
+
+	// the same column of different tables that are only distinct once their identifiers are kept apart
+	dottedSchema := newTestJobMapping("id")
+	dottedSchema.Schema = types.StringValue("a.b")
+	dottedSchema.Table = types.StringValue("c")
+	dottedTable := newTestJobMapping("id")
+	dottedTable.Schema = types.StringValue("a")
+	dottedTable.Table = types.StringValue("b.c")
+
+	testcases := []struct {
+		name     string
+		state    types.List
+		plan     types.List
+		expected types.List
+	}{
+		{"reordered dotted identifiers", newList(dottedSchema, dottedTable), newList(dottedTable, dottedSchema), newList(dottedSchema, dottedTable)},
+		{"reordered", newList(id, email), newList(email, id), newList(id, email)},
+		{"changed transformer", newList(id, email), newList(hashedEmail, id), newList(hashedEmail, id)},
+		{"added mapping", newList(id), newList(email, id), newList(email, id)},
+		{"removed mapping", newList(id, email), newList(email), newList(email)},
+		{"no state", types.ListNull(mappingsType.ElemType), newList(email, id), newList(email, id)},
+		{"unknown plan", newList(id, email), types.ListUnknown(mappingsType.ElemType), types.ListUnknown(mappingsType.ElemType)},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &planmodifier.ListResponse{PlanValue: tc.plan}
+			jobMappingsOrderInsensitive().PlanModifyList(ctx, planmodifier.ListRequest{
+				StateValue: tc.state,
+				PlanValue:  tc.plan,
+			}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.True(t, tc.expected.Equal(resp.PlanValue), "expected %s, got %s", tc.expected, resp.PlanValue)
+		})
+	}
+}
+
+func Test_uniqueJobMappings(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&JobResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	mappingsType, ok := schemaResp.Schema.Attributes["mappings"].GetType().(types.ListType)
+	require.True(t, ok)
+
+	newList := func(mappings ...*job_model.JobMapping) types.List {
+		list, diags := types.ListValueFrom(ctx, mappingsType.ElemType, mappings)
+		require.False(t, diags.HasError(), diags)
+		return list
+	}
+
+	dottedSchema := newTestJobMapping("id")
+	dottedSchema.Schema = types.StringValue("a.b")
+	dottedSchema.Table = types.StringValue("c")
+	dottedTable := newTestJobMapping("id")
+	dottedTable.Schema = types.StringValue("a")
+	dottedTable.Table = types.StringValue("b.c")
+
+	resp := &validator.ListResponse{}
+	uniqueJobMappings().ValidateList(ctx, validator.ListRequest{ConfigValue: newList(dottedSchema, dottedTable)}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	resp = &validator.ListResponse{}
+	uniqueJobMappings().ValidateList(ctx, validator.ListRequest{ConfigValue: newList(dottedSchema, dottedTable, dottedSchema)}, resp)
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+	require.Equal(t, "Duplicate Job Mapping", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -4,22 +4,36 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/terraform-provider-neosync/internal/models"
 	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
 )
 
 var _ resource.Resource = &JobResource{}
 var _ resource.ResourceWithImportState = &JobResource{}
+var _ resource.ResourceWithConfigValidators = &JobResource{}
+var _ resource.ResourceWithModifyPlan = &JobResource{}
+var _ resource.ResourceWithUpgradeState = &JobResource{}
+var _ resource.ResourceWithValidateConfig = &JobResource{}
 
 func NewJobResource() resource.Resource {
 	return &JobResource{}
@@ -34,14 +48,65 @@ func (r *JobResource) Metadata(ctx context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_job"
 }
 
+var (
+	destinationOnConflictSchema = schema.SingleNestedAttribute{
+		Description: "What to do when an inserted row conflicts with an existing row",
+		Optional:    true,
+		Validators:  []validator.Object{exactlyOneOfAttributes()},
+		Attributes: map[string]schema.Attribute{
+			"nothing": schema.SingleNestedAttribute{
+				Description: "Skip the conflicting row",
+				Optional:    true,
+				Attributes:  map[string]schema.Attribute{},
+			},
+			"update": schema.SingleNestedAttribute{
+				Description: "Update the non-key columns of the existing row with the values of the conflicting row",
+				Optional:    true,
+				Attributes:  map[string]schema.Attribute{},
+			},
+		},
+	}
+
+	destinationSkipForeignKeyViolationsSchema = schema.BoolAttribute{
+		Description: "Whether or not to skip rows that would violate a foreign key constraint instead of failing the job",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+
+	destinationMaxInFlightSchema = schema.Int64Attribute{
+		Description: "The maximum number of batches that will be written to the destination in parallel",
+		Optional:    true,
+		Validators:  []validator.Int64{int64validator.AtLeast(1)},
+	}
+
+	destinationBatchSchema = schema.SingleNestedAttribute{
+		Description: "Batching configuration for writes to the destination. A batch is flushed once either limit is reached",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"count": schema.Int64Attribute{
+				Description: "The maximum number of records in a batch",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"period": schema.StringAttribute{
+				Description: "The maximum amount of time to wait before flushing a batch. Must be a Go duration string, such as \"5s\" or \"1m\"",
+				Optional:    true,
+				CustomType:  models.DurationType{},
+			},
+		},
+	}
+)
+
 func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		Description: "Job resource",
+		Version:     1,
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Description: "The unique friendly name of the job",
+				Description: "The unique friendly name of the job. The name can not be changed once the job has been created",
 				Required:    true,
 			},
 			"account_id": schema.StringAttribute{
@@ -50,6 +115,27 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Computed:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured()},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether or not Terraform is prevented from deleting the job. It must be set to false and applied before the job can be destroyed",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"manage_mappings": schema.BoolAttribute{
+				Description: "Whether or not the job's mappings are managed by this resource. " +
+					"Set to false to manage individual mappings with neosync_job_mapping resources instead, in which case mappings must not be configured",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"manage_subsets": schema.BoolAttribute{
+				Description: "Whether or not the source's subsets are managed by this resource. " +
+					"Set to false to manage them with a neosync_job_source_subset resource instead, " +
+					"in which case the source's schemas, tables and subset_by_foreign_key_constraints must not be configured",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 
 			"source": schema.SingleNestedAttribute{
 				Description: "Configuration details about the source data connection",
@@ -62,6 +148,7 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							"new_column_addition_strategy": schema.SingleNestedAttribute{
 								Description: "Strategy for handling new column additions",
 								Optional:    true,
+								Validators:  []validator.Object{exactlyOneOfAttributes()},
 								Attributes: map[string]schema.Attribute{
 									"halt_job": schema.SingleNestedAttribute{
 										Description: "Halt job if a new column is detected",
@@ -78,6 +165,7 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							"column_removal_strategy": schema.SingleNestedAttribute{
 								Description: "Strategy for handling column removals",
 								Optional:    true,
+								Validators:  []validator.Object{exactlyOneOfAttributes()},
 								Attributes: map[string]schema.Attribute{
 									"halt_job": schema.SingleNestedAttribute{
 										Description: "Halt job if a column is detected",
@@ -143,9 +231,22 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 								Optional:    true,
 								Computed:    true,
 							},
+							"new_column_addition_strategy": schema.SingleNestedAttribute{
+								Description: "Strategy for handling new column additions. If not set, the job will continue when a new column is detected",
+								Optional:    true,
+								Validators:  []validator.Object{exactlyOneOfAttributes()},
+								Attributes: map[string]schema.Attribute{
+									"halt_job": schema.SingleNestedAttribute{
+										Description: "Halt job if a new column is detected",
+										Optional:    true,
+										Attributes:  map[string]schema.Attribute{},
+									},
+								},
+							},
 							"column_removal_strategy": schema.SingleNestedAttribute{
 								Description: "Strategy for handling column removals",
 								Optional:    true,
+								Validators:  []validator.Object{exactlyOneOfAttributes()},
 								Attributes: map[string]schema.Attribute{
 									"halt_job": schema.SingleNestedAttribute{
 										Description: "Halt job if a column is detected",
@@ -189,6 +290,209 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 						},
 					},
+					"mssql": schema.SingleNestedAttribute{
+						Description: "Mssql specific connection configurations",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"connection_id": schema.StringAttribute{
+								Description: "The unique identifier of the connection that is to be used as the source",
+								Required:    true,
+							},
+							"subset_by_foreign_key_constraints": schema.BoolAttribute{
+								Description: "Whether or not to subset the source tables by foreign key constraints",
+								Optional:    true,
+								Computed:    true,
+							},
+							"new_column_addition_strategy": schema.SingleNestedAttribute{
+								Description: "Strategy for handling new column additions. If not set, the job will continue when a new column is detected",
+								Optional:    true,
+								Validators:  []validator.Object{exactlyOneOfAttributes()},
+								Attributes: map[string]schema.Attribute{
+									"halt_job": schema.SingleNestedAttribute{
+										Description: "Halt job if a new column is detected",
+										Optional:    true,
+										Attributes:  map[string]schema.Attribute{},
+									},
+								},
+							},
+							"column_removal_strategy": schema.SingleNestedAttribute{
+								Description: "Strategy for handling column removals",
+								Optional:    true,
+								Validators:  []validator.Object{exactlyOneOfAttributes()},
+								Attributes: map[string]schema.Attribute{
+									"halt_job": schema.SingleNestedAttribute{
+										Description: "Halt job if a column is detected",
+										Optional:    true,
+										Attributes:  map[string]schema.Attribute{},
+									},
+									"continue_job": schema.SingleNestedAttribute{
+										Description: "Continue job even if a column is detected",
+										Optional:    true,
+										Attributes:  map[string]schema.Attribute{},
+									},
+								},
+							},
+							"schemas": schema.ListNestedAttribute{
+								Description: "A list of schemas and table specific options",
+								Optional:    true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"schema": schema.StringAttribute{
+											Description: "The name of the schema",
+											Required:    true,
+										},
+										"tables": schema.ListNestedAttribute{
+											Description: "A list of tables and their specific options within the defined schema",
+											Required:    true,
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"table": schema.StringAttribute{
+														Description: "The name of the table",
+														Required:    true,
+													},
+													"where_clause": schema.StringAttribute{
+														Description: "A where clause that will be used to subset the table during sync",
+														Optional:    true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"mongodb": schema.SingleNestedAttribute{
+						Description: "MongoDB specific connection configurations",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"connection_id": schema.StringAttribute{
+								Description: "The unique identifier of the connection that is to be used as the source",
+								Required:    true,
+							},
+						},
+					},
+					"dynamodb": schema.SingleNestedAttribute{
+						Description: "DynamoDB specific connection configurations",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"connection_id": schema.StringAttribute{
+								Description: "The unique identifier of the connection that is to be used as the source",
+								Required:    true,
+							},
+							"tables": schema.ListNestedAttribute{
+								Description: "A list of tables and their specific options",
+								Optional:    true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"table": schema.StringAttribute{
+											Description: "The name of the table",
+											Required:    true,
+										},
+										"where_clause": schema.StringAttribute{
+											Description: "A PartiQL where clause that will be used to subset the table during sync",
+											Optional:    true,
+										},
+									},
+								},
+							},
+							"unmapped_transforms": schema.SingleNestedAttribute{
+								Description: "The transformers that will be performed on attributes that are not part of the job mappings, keyed by their DynamoDB type",
+								Optional:    true,
+								Attributes: map[string]schema.Attribute{
+									"b": schema.SingleNestedAttribute{
+										Description: "The transformer that will be performed on unmapped binary attributes",
+										Optional:    true,
+										Attributes: map[string]schema.Attribute{
+											"config": transformerSchema,
+										},
+									},
+									"boolean": schema.SingleNestedAttribute{
+										Description: "The transformer that will be performed on unmapped boolean attributes",
+										Optional:    true,
+										Attributes: map[string]schema.Attribute{
+											"config": transformerSchema,
+										},
+									},
+									"n": schema.SingleNestedAttribute{
+										Description: "The transformer that will be performed on unmapped number attributes",
+										Optional:    true,
+										Attributes: map[string]schema.Attribute{
+											"config": transformerSchema,
+										},
+									},
+									"s": schema.SingleNestedAttribute{
+										Description: "The transformer that will be performed on unmapped string attributes",
+										Optional:    true,
+										Attributes: map[string]schema.Attribute{
+											"config": transformerSchema,
+										},
+									},
+								},
+							},
+							"enable_consistent_read": schema.BoolAttribute{
+								Description: "Whether or not to use strongly consistent reads when scanning the source tables",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+						},
+					},
+					"ai_generate": schema.SingleNestedAttribute{
+						Description: "AI Generate specific connection configurations. Rows are generated by a large language model using the configured prompt",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"ai_connection_id": schema.StringAttribute{
+								Description: "The unique identifier of the AI connection that is used to generate the rows",
+								Required:    true,
+							},
+							"fk_source_connection_id": schema.StringAttribute{
+								Description: "The unique connection identifier that is used to generate schema specific details. This is usually set to the destination connection id if it has been upserted with the schema already",
+								Optional:    true,
+							},
+							"model_name": schema.StringAttribute{
+								Description: "The name of the model that will be used to generate the rows",
+								Required:    true,
+							},
+							"user_prompt": schema.StringAttribute{
+								Description: "An optional prompt that is provided to the model to guide the data that is generated",
+								Optional:    true,
+							},
+							"generate_batch_size": schema.Int64Attribute{
+								Description: "The number of rows that are requested from the model at a time",
+								Optional:    true,
+								Validators:  []validator.Int64{int64validator.AtLeast(1)},
+							},
+							"schemas": schema.ListNestedAttribute{
+								Description: "A list of schemas and table specific options",
+								Required:    true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"schema": schema.StringAttribute{
+											Description: "The name of the schema",
+											Required:    true,
+										},
+										"tables": schema.ListNestedAttribute{
+											Description: "A list of tables and their specific options within the defined schema",
+											Required:    true,
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"table": schema.StringAttribute{
+														Description: "The name of the table",
+														Required:    true,
+													},
+													"row_count": schema.Int64Attribute{
+														Description: "The number of rows to generate into the table",
+														Required:    true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 					"aws_s3": schema.SingleNestedAttribute{
 						Description: "AWS S3 specific connection configurations",
 						Optional:    true,
@@ -239,20 +543,23 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					},
 				},
 			},
-			"destinations": schema.ListNestedAttribute{
-				Description: "A list of destination connections and any relevant configurations that are available to them dependent on type",
-				Required:    true,
+			"destinations": schema.MapNestedAttribute{
+				Description: "The destination connections and any relevant configurations that are available to them dependent on type. " +
+					"Destinations are keyed by a user chosen name that identifies them across updates, such as the connection name. " +
+					"Destinations that are imported are keyed by their connection id. " +
+					"Renaming a key updates the destination in place as long as its connection_id does not change",
+				Required: true,
 				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{exactlyOneOfAttributes("postgres", "mysql", "mssql", "aws_s3", "mongodb", "dynamodb", "gcp_cloud_storage")},
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
 							Description:   "The unique identifier of the destination resource. This is set after creation",
-							Optional:      true,
 							Computed:      true,
 							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 						},
 						"connection_id": schema.StringAttribute{
 							Description: "The unique identifier of the connection that will be used during the synchronization process",
-							Optional:    true, // required if id is not set
+							Required:    true,
 						},
 						"postgres": schema.SingleNestedAttribute{
 							Description: "Postgres connection specific options",
@@ -276,6 +583,10 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									Description: "Whether or not to have neosync init the table schema and constraints it pulled from the source connection",
 									Required:    true,
 								},
+								"on_conflict":                 destinationOnConflictSchema,
+								"skip_foreign_key_violations": destinationSkipForeignKeyViolationsSchema,
+								"max_in_flight":               destinationMaxInFlightSchema,
+								"batch":                       destinationBatchSchema,
 							},
 						},
 						"mysql": schema.SingleNestedAttribute{
@@ -296,19 +607,106 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									Description: "Whether or not to have neosync init the table schema and constraints it pulled from the source connection",
 									Required:    true,
 								},
+								"on_conflict":                 destinationOnConflictSchema,
+								"skip_foreign_key_violations": destinationSkipForeignKeyViolationsSchema,
+								"max_in_flight":               destinationMaxInFlightSchema,
+								"batch":                       destinationBatchSchema,
 							},
 						},
 						"aws_s3": schema.SingleNestedAttribute{
 							Description: "AWS S3 connection specific options",
 							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"storage_class": schema.StringAttribute{
+									Description: fmt.Sprintf("The storage class of the objects written to the bucket. One of: %s", strings.Join(job_model.AwsS3StorageClasses, ", ")),
+									Optional:    true,
+									Validators:  []validator.String{stringvalidator.OneOf(job_model.AwsS3StorageClasses...)},
+								},
+								"max_in_flight": destinationMaxInFlightSchema,
+								"timeout": schema.StringAttribute{
+									Description: "The maximum amount of time to wait for an object to be written. Must be a Go duration string, such as \"5s\" or \"1m\"",
+									Optional:    true,
+									CustomType:  models.DurationType{},
+								},
+								"batch": destinationBatchSchema,
+							},
+						},
+						"mssql": schema.SingleNestedAttribute{
+							Description: "Mssql connection specific options",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"truncate_table": schema.SingleNestedAttribute{
+									Description: "Details about what truncation should occur",
+									Optional:    true,
+									Attributes: map[string]schema.Attribute{
+										"truncate_before_insert": schema.BoolAttribute{
+											Description: "Will truncate the table prior to insertion of any records",
+											Optional:    true,
+										},
+									},
+								},
+								"init_table_schema": schema.BoolAttribute{
+									Description: "Whether or not to have neosync init the table schema and constraints it pulled from the source connection",
+									Required:    true,
+								},
+								"on_conflict": schema.SingleNestedAttribute{
+									Description: "What to do when an inserted row conflicts with an existing row",
+									Optional:    true,
+									Validators:  []validator.Object{exactlyOneOfAttributes()},
+									Attributes: map[string]schema.Attribute{
+										"nothing": schema.SingleNestedAttribute{
+											Description: "Skip the conflicting row",
+											Optional:    true,
+											Attributes:  map[string]schema.Attribute{},
+										},
+									},
+								},
+								"skip_foreign_key_violations": destinationSkipForeignKeyViolationsSchema,
+								"max_in_flight":               destinationMaxInFlightSchema,
+								"batch":                       destinationBatchSchema,
+							},
+						},
+						"mongodb": schema.SingleNestedAttribute{
+							Description: "MongoDB connection specific options",
+							Optional:    true,
+							Attributes:  map[string]schema.Attribute{},
+						},
+						"dynamodb": schema.SingleNestedAttribute{
+							Description: "DynamoDB connection specific options",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"table_mappings": schema.ListNestedAttribute{
+									Description: "Maps each source table to the DynamoDB table it will be written to",
+									Required:    true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"source_table": schema.StringAttribute{
+												Description: "The name of the table in the source connection",
+												Required:    true,
+											},
+											"destination_table": schema.StringAttribute{
+												Description: "The name of the table in the destination connection",
+												Required:    true,
+											},
+										},
+									},
+								},
+							},
+						},
+						"gcp_cloud_storage": schema.SingleNestedAttribute{
+							Description: "GCP Cloud Storage connection specific options",
+							Optional:    true,
 							Attributes:  map[string]schema.Attribute{},
 						},
 					},
 				},
 			},
 			"mappings": schema.ListNestedAttribute{
-				Description: "Details each schema,table,column along with the transformation that will be executed",
-				Optional:    true,
+				Description: "Details each schema,table,column along with the transformation that will be executed. " +
+					"Mappings are identified by their schema, table and column, so changing only their order does not cause a diff",
+				Optional:      true,
+				Validators:    []validator.List{uniqueJobMappings()},
+				PlanModifiers: []planmodifier.List{jobMappingsOrderInsensitive()},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"schema": schema.StringAttribute{
@@ -339,6 +737,13 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Optional:    true,
 				Computed:    true,
 			},
+			"paused": schema.BoolAttribute{
+				Description: "Whether or not the job's schedule is paused. Pausing keeps the cron schedule so that it can be resumed later. " +
+					"If not configured, the job's current status is left as is. Jobs that are created without a cron schedule start out paused",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
 
 			"sync_options": schema.SingleNestedAttribute{
 				Description: "Advanced settings and other options specific to a table sync",
@@ -428,6 +833,21 @@ func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	}
 }
 
+func (r *JobResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("source").AtName("postgres"),
+			path.MatchRoot("source").AtName("mysql"),
+			path.MatchRoot("source").AtName("mssql"),
+			path.MatchRoot("source").AtName("aws_s3"),
+			path.MatchRoot("source").AtName("mongodb"),
+			path.MatchRoot("source").AtName("dynamodb"),
+			path.MatchRoot("source").AtName("generate"),
+			path.MatchRoot("source").AtName("ai_generate"),
+		),
+	}
+}
+
 func (r *JobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -485,6 +905,33 @@ func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, re
 		resp.Diagnostics.AddError("job translate error", err.Error())
 		return
 	}
+	newModel.MergePrior(&data)
+
+	// The job exists at this point, so it is saved to the state before its status is changed.
+	// If pausing fails the job is tainted and replaced on the next apply instead of being left behind.
+	newModel.Paused = types.BoolNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paused, err := getJobPaused(ctx, r.client, job.GetId())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get job status", err.Error())
+		return
+	}
+	if !data.Paused.IsNull() && !data.Paused.IsUnknown() && data.Paused.ValueBool() != paused {
+		_, err = r.client.PauseJob(ctx, connect.NewRequest(&mgmtv1alpha1.PauseJobRequest{
+			Id:    job.GetId(),
+			Pause: data.Paused.ValueBool(),
+		}))
+		if err != nil {
+			resp.Diagnostics.AddError("unable to pause or resume job", err.Error())
+			return
+		}
+		paused = data.Paused.ValueBool()
+	}
+	newModel.Paused = types.BoolValue(paused)
 
 	tflog.Trace(ctx, "mapped job to model during creation")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
@@ -516,6 +963,14 @@ func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	paused, err := getJobPaused(ctx, r.client, job.GetId())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get job status", err.Error())
+		return
+	}
+	newModel.Paused = types.BoolValue(paused)
+
+	newModel.MergePrior(&data)
 	tflog.Trace(ctx, "mapped job to model")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
 }
@@ -540,48 +995,9 @@ func (r *JobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	if updateJobRequest.UpdateJobScheduleRequest != nil {
-		_, err = r.client.UpdateJobSchedule(ctx, connect.NewRequest(updateJobRequest.UpdateJobScheduleRequest))
-		if err != nil {
-			resp.Diagnostics.AddError("unable to update job schedule", err.Error())
-			return
-		}
-	}
-
-	if updateJobRequest.UpdateJobSourceConnectionRequest != nil {
-		_, err = r.client.UpdateJobSourceConnection(ctx, connect.NewRequest(updateJobRequest.UpdateJobSourceConnectionRequest))
-		if err != nil {
-			resp.Diagnostics.AddError("unable to update job source connection", err.Error())
-			return
-		}
-	}
-
-	if updateJobRequest.CreateJobDestinationConnectionsRequest != nil {
-		_, err = r.client.CreateJobDestinationConnections(ctx, connect.NewRequest(updateJobRequest.CreateJobDestinationConnectionsRequest))
-		if err != nil {
-			resp.Diagnostics.AddError("unable to create job destination connections", err.Error())
-			return
-		}
-	}
-
-	if len(updateJobRequest.UpdateJobDestinationConnectionRequests) > 0 {
-		for _, req := range updateJobRequest.UpdateJobDestinationConnectionRequests {
-			_, err = r.client.UpdateJobDestinationConnection(ctx, connect.NewRequest(req))
-			if err != nil {
-				resp.Diagnostics.AddError("unable to update job destination connection", err.Error())
-				return
-			}
-		}
-	}
-
-	if len(updateJobRequest.DeleteJobDestinationConnectionRequests) > 0 {
-		for _, req := range updateJobRequest.DeleteJobDestinationConnectionRequests {
-			_, err = r.client.DeleteJobDestinationConnection(ctx, connect.NewRequest(req))
-			if err != nil {
-				resp.Diagnostics.AddError("unable to delete job destination connection", err.Error())
-				return
-			}
-		}
+	resp.Diagnostics.Append(applyJobUpdate(ctx, r.client, updateJobRequest)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	getResp, err := r.client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
@@ -601,10 +1017,95 @@ func (r *JobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	paused, err := getJobPaused(ctx, r.client, job.GetId())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get job status", err.Error())
+		return
+	}
+	updatedModel.Paused = types.BoolValue(paused)
+
+	updatedModel.MergePrior(&planModel)
 	tflog.Trace(ctx, "updated job")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedModel)...)
 }
 
+func (r *JobResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var manageMappings types.Bool
+	var mappings types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("manage_mappings"), &manageMappings)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mappings"), &mappings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !manageMappings.IsNull() && !manageMappings.IsUnknown() && !manageMappings.ValueBool() && !mappings.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mappings"),
+			"Invalid Attribute Combination",
+			"mappings can not be configured when manage_mappings is false. Use neosync_job_mapping resources to manage the job's mappings instead",
+		)
+	}
+
+	var manageSubsets types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("manage_subsets"), &manageSubsets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if manageSubsets.IsNull() || manageSubsets.IsUnknown() || manageSubsets.ValueBool() {
+		return
+	}
+
+	subsetPaths := []path.Path{}
+	for _, sourceType := range []string{"postgres", "mysql", "mssql"} {
+		subsetPaths = append(subsetPaths,
+			path.Root("source").AtName(sourceType).AtName("schemas"),
+			path.Root("source").AtName(sourceType).AtName("subset_by_foreign_key_constraints"),
+		)
+	}
+	subsetPaths = append(subsetPaths, path.Root("source").AtName("dynamodb").AtName("tables"))
+
+	for _, subsetPath := range subsetPaths {
+		var value attr.Value
+		diags := req.Config.GetAttribute(ctx, subsetPath, &value)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		if value == nil || value.IsNull() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			subsetPath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s can not be configured when manage_subsets is false. Use a neosync_job_source_subset resource to manage the job's subsets instead", subsetPath),
+		)
+	}
+}
+
+// The job service does not expose a way to rename a job, so a name change is rejected at plan time
+// instead of being dropped or forcing a replacement that would lose the job's run history.
+func (r *JobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateName, planName types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &planName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planName.IsUnknown() || planName.Equal(stateName) {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("name"),
+		"Job name can not be updated",
+		fmt.Sprintf("renaming job %q to %q is not supported by the Neosync API. Revert the name, or create a new job with the desired name", stateName.ValueString(), planName.ValueString()),
+	)
+}
+
 func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data job_model.JobResourceModel
 
@@ -613,6 +1114,14 @@ func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Job is protected from deletion",
+			fmt.Sprintf("job %q has deletion_protection enabled. Set deletion_protection = false and apply before destroying it", data.Name.ValueString()),
+		)
+		return
+	}
+
 	_, err := r.client.DeleteJob(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteJobRequest{
 		Id: data.Id.ValueString(),
 	}))
@@ -626,27 +1135,51 @@ func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 func (r *JobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	if req.ID == "" {
-		resp.Diagnostics.AddError("Unable to import", "must provide ID")
+		resp.Diagnostics.AddError("Unable to import", "must provide ID or name:<name>")
 		return
 	}
 
-	jobResp, err := r.client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
-		Id: req.ID,
-	}))
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to get job", err.Error())
-		return
+	var job *mgmtv1alpha1.Job
+	if name, ok := parseImportName(req.ID); ok {
+		if r.accountId == nil {
+			resp.Diagnostics.AddError("Unable to import", "must configure the provider with an account id to import by name")
+			return
+		}
+		namedJob, err := getJobByName(ctx, r.client, *r.accountId, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get job by name", err.Error())
+			return
+		}
+		job = namedJob
+	} else {
+		jobResp, err := r.client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+			Id: req.ID,
+		}))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get job", err.Error())
+			return
+		}
+		job = jobResp.Msg.GetJob()
 	}
 	tflog.Trace(ctx, "retrieved job during import")
-	job := jobResp.Msg.GetJob()
 
 	var data job_model.JobResourceModel
-	err = data.FromDto(job)
+	err := data.FromDto(job)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to map job to model", err.Error())
 		return
 	}
 
+	paused, err := getJobPaused(ctx, r.client, job.GetId())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get job status", err.Error())
+		return
+	}
+	data.Paused = types.BoolValue(paused)
+
+	data.DeletionProtection = types.BoolValue(false)
+	data.ManageMappings = types.BoolValue(true)
+	data.ManageSubsets = types.BoolValue(true)
 	tflog.Trace(ctx, "mapped job to model during import")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -665,3 +1198,32 @@ func (r *JobResource) getAccountId(data *job_model.JobResourceModel) (string, er
 	}
 	return accountId, nil
 }
+
+// Returns whether or not the job's schedule is currently paused
+func getJobPaused(ctx context.Context, client mgmtv1alpha1connect.JobServiceClient, jobId string) (bool, error) {
+	statusResp, err := client.GetJobStatus(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobStatusRequest{
+		JobId: jobId,
+	}))
+	if err != nil {
+		return false, err
+	}
+	return statusResp.Msg.GetStatus() == mgmtv1alpha1.JobStatus_JOB_STATUS_PAUSED, nil
+}
+
+// Finds the job with the given name in the account.
+// Returns an error if no job or more than one job has that name.
+func getJobByName(
+	ctx context.Context,
+	client mgmtv1alpha1connect.JobServiceClient,
+	accountId string,
+	name string,
+) (*mgmtv1alpha1.Job, error) {
+	jobsResp, err := client.GetJobs(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobsRequest{
+		AccountId: accountId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return findOneByName(jobsResp.Msg.GetJobs(), "job", accountId, name)
+}
@@ -1,11 +1,25 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"connectrpc.com/connect"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
+	transformer_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/transformers"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAcc_Job_Pg_Pg(t *testing.T) {
@@ -34,8 +48,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -45,7 +59,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -88,7 +102,7 @@ resource "neosync_job" "job1" {
 	})
 }
 
-func TestAcc_Job_Pg_Pg_Mappings(t *testing.T) {
+func TestAcc_Job_Pg_Pg_DestinationOptions(t *testing.T) {
 	name := acctest.RandString(10)
 
 	config := fmt.Sprintf(`
@@ -114,18 +128,711 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+				on_conflict = {
+					update = {}
+				}
+				skip_foreign_key_violations = true
+				max_in_flight = 5
+				batch = {
+					count = 100
+					period = "5s"
+				}
+			}
+		}
+	}
+	mappings = [
+		{
+			schema = "public"
+			table = "users"
+			column = "id"
+			transformer = {
+				config = {
+					passthrough = {}
+				}
+			}
+		},
 		{
+			schema = "public"
+			table = "users"
+			column = "id2"
+			transformer = {
+				config = {
+					transform_email = {
+						preserve_domain = false
+						preserve_length = true
+					}
+				}
+			}
+		}
+	]
+}
+	`, name, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.postgres.skip_foreign_key_violations", "true"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.postgres.max_in_flight", "5"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.postgres.batch.count", "100"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.postgres.batch.period", "5s"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Job_Pg_Pg_Options(t *testing.T) {
+	name := acctest.RandString(10)
+
+	getConfig := func(options string) string {
+		return fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
-				truncate_table = {
-					truncate_before_insert = true
-					cascade = true
+			}
+		}
+	}
+	mappings = [
+		{
+			schema = "public"
+			table = "users"
+			column = "id"
+			transformer = {
+				config = {
+					passthrough = {}
+				}
+			}
+		}
+	]
+	%s
+}
+	`, name, name, name, options)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getConfig(`
+	sync_options = {
+		start_to_close_timeout = 60
+		retry_policy = {
+			maximum_attempts = 2
+		}
+	}
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "sync_options.start_to_close_timeout", "60"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "sync_options.retry_policy.maximum_attempts", "2"),
+					resource.TestCheckNoResourceAttr("neosync_job.job1", "workflow_options"),
+				),
+			},
+			{
+				Config: getConfig(`
+	sync_options = {
+		start_to_close_timeout = 120
+		retry_policy = {
+			maximum_attempts = 5
+		}
+	}
+	workflow_options = {
+		run_timeout = 3600
+	}
+				`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("neosync_job.job1", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_job.job1", "sync_options.start_to_close_timeout", "120"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "sync_options.retry_policy.maximum_attempts", "5"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "workflow_options.run_timeout", "3600"),
+				),
+			},
+			{
+				Config: getConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("neosync_job.job1", "sync_options"),
+					resource.TestCheckNoResourceAttr("neosync_job.job1", "workflow_options"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Job_Rename(t *testing.T) {
+	name := acctest.RandString(10)
+
+	getConfig := func(jobName string) string {
+		return fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+			}
+		}
+	}
+	mappings = [
+		{
+			schema = "public"
+			table = "users"
+			column = "id"
+			transformer = {
+				config = {
+					passthrough = {}
+				}
+			}
+		}
+	]
+}
+	`, name, name, jobName)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_job.job1", "name", name),
+				),
+			},
+			{
+				Config:      getConfig(name + "-renamed"),
+				ExpectError: regexp.MustCompile("Job name can not be updated"),
+			},
+		},
+	})
+}
+
+func TestAcc_Job_DeletionProtection(t *testing.T) {
+	name := acctest.RandString(10)
+
+	getConfig := func(deletionProtection bool) string {
+		return fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	deletion_protection = %t
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+			}
+		}
+	}
+	mappings = [
+		{
+			schema = "public"
+			table = "users"
+			column = "id"
+			transformer = {
+				config = {
+					passthrough = {}
 				}
 			}
 		}
 	]
+}
+	`, name, name, name, deletionProtection)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_job.job1", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      getConfig(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Job is protected from deletion"),
+			},
+			{
+				Config: getConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_job.job1", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Job_Paused(t *testing.T) {
+	name := acctest.RandString(10)
+
+	getConfig := func(paused bool) string {
+		return fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	cron_schedule = "0 0 * * *"
+	paused = %t
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+			}
+		}
+	}
+	mappings = [
+		{
+			schema = "public"
+			table = "users"
+			column = "id"
+			transformer = {
+				config = {
+					passthrough = {}
+				}
+			}
+		}
+	]
+}
+	`, name, name, name, paused)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_job.job1", "paused", "false"),
+				),
+			},
+			{
+				Config: getConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_job.job1", "paused", "true"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "cron_schedule", "0 0 * * *"),
+				),
+			},
+			{
+				Config: getConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_job.job1", "paused", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Job_Pg_Pg_Mappings(t *testing.T) {
+	name := acctest.RandString(10)
+
+	config := fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+				truncate_table = {
+					truncate_before_insert = true
+					cascade = true
+				}
+			}
+		}
+	}
+	mappings = [
+		{
+			schema = "public"
+			table = "users"
+			column = "id"
+			transformer = {
+				source = "passthrough"
+				config = {
+					passthrough = {}
+				}
+			}
+		}
+	]
+}
+	`, name, name, name)
+
+	config1 := fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+				truncate_table = {
+					truncate_before_insert = true
+					cascade = true
+				}
+			}
+		}
+	}
+	mappings = [
+		{
+			schema = "public"
+			table = "users"
+			column = "id"
+			transformer = {
+				source = "passthrough"
+				config = {
+					passthrough = {}
+				}
+			}
+		},
+		{
+			schema = "public"
+			table = "users"
+			column = "name"
+			transformer = {
+				source = "passthrough"
+				config = {
+					passthrough = {}
+				}
+			}
+		}
+	]
+}
+	`, name, name, name)
+
+	config2 := fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+				truncate_table = {
+					truncate_before_insert = true
+					cascade = true
+				}
+			}
+		}
+	}
+	mappings = [
+		{
+			schema = "public"
+			table = "users"
+			column = "name"
+			transformer = {
+				source = "passthrough"
+				config = {
+					passthrough = {}
+				}
+			}
+		}
+	]
+}
+	`, name, name, name)
+
+	var accountID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "account_id"),
+					GetAccountIdFromState("neosync_job.job1", func(accountId string) { accountID = accountId }),
+				),
+			},
+			{
+				Config: config1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "account_id"),
+					GetTestAccountIdFromStateFn("neosync_job.job1", func() string { return accountID }),
+				),
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "account_id"),
+					GetTestAccountIdFromStateFn("neosync_job.job1", func() string { return accountID }),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Job_Pg_Pg_DuplicateMappings(t *testing.T) {
+	name := acctest.RandString(10)
+
+	config := fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+			}
+		}
+	}
+	mappings = [
+		{
+			schema = "public"
+			table = "users"
+			column = "id"
+			transformer = {
+				config = {
+					passthrough = {}
+				}
+			}
+		},
+		{
+			schema = "public"
+			table = "users"
+			column = "id"
+			transformer = {
+				config = {
+					null = {}
+				}
+			}
+		}
+	]
+}
+	`, name, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("Duplicate Job Mapping"),
+			},
+		},
+	})
+}
+
+func TestAcc_Job_Pg_Pg_Destinations(t *testing.T) {
+	name := acctest.RandString(10)
+
+	config := fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+				truncate_table = {
+					truncate_before_insert = true
+					cascade = true
+				}
+			}
+		}
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -157,6 +864,13 @@ resource "neosync_connection" "destination" {
 		url = "test-url2"
 	}
 }
+resource "neosync_connection" "destination2" {
+	name = "%s-dest2"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
 
 resource "neosync_job" "job1" {
 	name = "%s"
@@ -165,8 +879,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -176,23 +890,22 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
-	mappings = [
-		{
-			schema = "public"
-			table = "users"
-			column = "id"
-			transformer = {
-				source = "passthrough"
-				config = {
-					passthrough = {}
+		destination2 = {
+			connection_id = neosync_connection.destination2.id
+			postgres = {
+				init_table_schema = false
+				truncate_table = {
+					truncate_before_insert = true
+					cascade = true
 				}
 			}
-		},
+		}
+	}
+	mappings = [
 		{
 			schema = "public"
 			table = "users"
-			column = "name"
+			column = "id"
 			transformer = {
 				source = "passthrough"
 				config = {
@@ -202,7 +915,7 @@ resource "neosync_job" "job1" {
 		}
 	]
 }
-	`, name, name, name)
+	`, name, name, name, name)
 
 	config2 := fmt.Sprintf(`
 resource "neosync_connection" "source" {
@@ -212,13 +925,20 @@ resource "neosync_connection" "source" {
 		url = "test-url"
 	}
 }
-resource "neosync_connection" "destination" {
-	name = "%s-dest"
+resource "neosync_connection" "destination2" {
+	name = "%s-dest2"
 
 	postgres = {
 		url = "test-url2"
 	}
 }
+resource "neosync_connection" "destination3" {
+	name = "%s-dest3"
+
+	postgres = {
+		url = "test-url3"
+	}
+}
 
 resource "neosync_job" "job1" {
 	name = "%s"
@@ -227,9 +947,19 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
-			connection_id = neosync_connection.destination.id
+	destinations = {
+		destination2 = {
+			connection_id = neosync_connection.destination2.id
+			postgres = {
+				init_table_schema = true
+				truncate_table = {
+					truncate_before_insert = true
+					cascade = true
+				}
+			}
+		}
+		destination3 = {
+			connection_id = neosync_connection.destination3.id
 			postgres = {
 				init_table_schema = false
 				truncate_table = {
@@ -238,12 +968,12 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
 			table = "users"
-			column = "name"
+			column = "id"
 			transformer = {
 				source = "passthrough"
 				config = {
@@ -253,9 +983,7 @@ resource "neosync_job" "job1" {
 		}
 	]
 }
-	`, name, name, name)
-
-	var accountID string
+	`, name, name, name, name)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -265,45 +993,44 @@ resource "neosync_job" "job1" {
 				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
-					resource.TestCheckResourceAttrSet("neosync_job.job1", "account_id"),
-					GetAccountIdFromState("neosync_job.job1", func(accountId string) { accountID = accountId }),
 				),
 			},
 			{
 				Config: config1,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
-					resource.TestCheckResourceAttrSet("neosync_job.job1", "account_id"),
-					GetTestAccountIdFromStateFn("neosync_job.job1", func() string { return accountID }),
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "destinations.destination.id"),
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "destinations.destination2.id"),
 				),
 			},
 			{
 				Config: config2,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
-					resource.TestCheckResourceAttrSet("neosync_job.job1", "account_id"),
-					GetTestAccountIdFromStateFn("neosync_job.job1", func() string { return accountID }),
+					resource.TestCheckNoResourceAttr("neosync_job.job1", "destinations.destination.id"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination2.postgres.init_table_schema", "true"),
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "destinations.destination3.id"),
 				),
 			},
 		},
 	})
 }
 
-func TestAcc_Job_Pg_Pg_Destinations(t *testing.T) {
+func TestAcc_Job_Mysql_Mysql(t *testing.T) {
 	name := acctest.RandString(10)
 
 	config := fmt.Sprintf(`
 resource "neosync_connection" "source" {
 	name = "%s-src"
 
-	postgres = {
+	mysql = {
 		url = "test-url"
 	}
 }
 resource "neosync_connection" "destination" {
 	name = "%s-dest"
 
-	postgres = {
+	mysql = {
 		url = "test-url2"
 	}
 }
@@ -311,22 +1038,21 @@ resource "neosync_connection" "destination" {
 resource "neosync_job" "job1" {
 	name = "%s"
 	source = {
-		postgres = {
+		mysql = {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
-			postgres = {
+			mysql = {
 				init_table_schema = false
 				truncate_table = {
 					truncate_before_insert = true
-					cascade = true
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -343,25 +1069,35 @@ resource "neosync_job" "job1" {
 }
 	`, name, name, name)
 
-	config1 := fmt.Sprintf(`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Job_Mysql_Mysql_SchemaStrategies(t *testing.T) {
+	name := acctest.RandString(10)
+
+	config := fmt.Sprintf(`
 resource "neosync_connection" "source" {
 	name = "%s-src"
 
-	postgres = {
+	mysql = {
 		url = "test-url"
 	}
 }
 resource "neosync_connection" "destination" {
 	name = "%s-dest"
 
-	postgres = {
-		url = "test-url2"
-	}
-}
-resource "neosync_connection" "destination2" {
-	name = "%s-dest2"
-
-	postgres = {
+	mysql = {
 		url = "test-url2"
 	}
 }
@@ -369,39 +1105,33 @@ resource "neosync_connection" "destination2" {
 resource "neosync_job" "job1" {
 	name = "%s"
 	source = {
-		postgres = {
+		mysql = {
 			connection_id = neosync_connection.source.id
+			new_column_addition_strategy = {
+				halt_job = {}
+			}
+			column_removal_strategy = {
+				continue_job = {}
+			}
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
-			postgres = {
-				init_table_schema = false
-				truncate_table = {
-					truncate_before_insert = true
-					cascade = true
-				}
-			}
-		},
-		{
-			connection_id = neosync_connection.destination2.id
-			postgres = {
+			mysql = {
 				init_table_schema = false
 				truncate_table = {
 					truncate_before_insert = true
-					cascade = true
 				}
 			}
-		},
-	]
+		}
+	}
 	mappings = [
 		{
 			schema = "public"
 			table = "users"
 			column = "id"
 			transformer = {
-				source = "passthrough"
 				config = {
 					passthrough = {}
 				}
@@ -409,67 +1139,73 @@ resource "neosync_job" "job1" {
 		}
 	]
 }
-	`, name, name, name, name)
+	`, name, name, name)
 
-	config2 := fmt.Sprintf(`
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "source.mysql.new_column_addition_strategy.halt_job.%", "0"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "source.mysql.column_removal_strategy.continue_job.%", "0"),
+					resource.TestCheckNoResourceAttr("neosync_job.job1", "source.mysql.column_removal_strategy.halt_job.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Job_Mysql_Mysql_DestinationOptions(t *testing.T) {
+	name := acctest.RandString(10)
+
+	config := fmt.Sprintf(`
 resource "neosync_connection" "source" {
 	name = "%s-src"
 
-	postgres = {
+	mysql = {
 		url = "test-url"
 	}
 }
-resource "neosync_connection" "destination2" {
-	name = "%s-dest2"
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
 
-	postgres = {
+	mysql = {
 		url = "test-url2"
 	}
 }
-resource "neosync_connection" "destination3" {
-	name = "%s-dest3"
-
-	postgres = {
-		url = "test-url3"
-	}
-}
 
 resource "neosync_job" "job1" {
 	name = "%s"
 	source = {
-		postgres = {
+		mysql = {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
-			connection_id = neosync_connection.destination2.id
-			postgres = {
-				init_table_schema = true
-				truncate_table = {
-					truncate_before_insert = true
-					cascade = true
-				}
-			}
-		},
-		{
-			connection_id = neosync_connection.destination3.id
-			postgres = {
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			mysql = {
 				init_table_schema = false
-				truncate_table = {
-					truncate_before_insert = true
-					cascade = true
+				on_conflict = {
+					nothing = {}
+				}
+				skip_foreign_key_violations = true
+				max_in_flight = 2
+				batch = {
+					count = 50
 				}
 			}
-		},
-	]
+		}
+	}
 	mappings = [
 		{
 			schema = "public"
 			table = "users"
 			column = "id"
 			transformer = {
-				source = "passthrough"
 				config = {
 					passthrough = {}
 				}
@@ -477,9 +1213,7 @@ resource "neosync_job" "job1" {
 		}
 	]
 }
-	`, name, name, name, name)
-
-	_ = config2
+	`, name, name, name)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -489,25 +1223,16 @@ resource "neosync_job" "job1" {
 				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.mysql.skip_foreign_key_violations", "true"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.mysql.max_in_flight", "2"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.mysql.batch.count", "50"),
 				),
 			},
-			{
-				Config: config1,
-				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
-				),
-			},
-			// {
-			// 	Config: config2,
-			// 	Check: resource.ComposeAggregateTestCheckFunc(
-			// 		resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
-			// 	),
-			// },
 		},
 	})
 }
 
-func TestAcc_Job_Mysql_Mysql(t *testing.T) {
+func TestAcc_Job_Mysql_Aws(t *testing.T) {
 	name := acctest.RandString(10)
 
 	config := fmt.Sprintf(`
@@ -521,8 +1246,8 @@ resource "neosync_connection" "source" {
 resource "neosync_connection" "destination" {
 	name = "%s-dest"
 
-	mysql = {
-		url = "test-url2"
+	aws_s3 = {
+		bucket = "test-bucket"
 	}
 }
 
@@ -533,17 +1258,12 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
-			mysql = {
-				init_table_schema = false
-				truncate_table = {
-					truncate_before_insert = true
-				}
-			}
+			aws_s3 = {}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -574,7 +1294,7 @@ resource "neosync_job" "job1" {
 	})
 }
 
-func TestAcc_Job_Mysql_Aws(t *testing.T) {
+func TestAcc_Job_Mysql_Aws_DestinationOptions(t *testing.T) {
 	name := acctest.RandString(10)
 
 	config := fmt.Sprintf(`
@@ -600,19 +1320,26 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
-			aws_s3 = {}
+			aws_s3 = {
+				storage_class = "glacier"
+				max_in_flight = 10
+				timeout = "30s"
+				batch = {
+					count = 1000
+					period = "1m"
+				}
+			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
 			table = "users"
 			column = "id"
 			transformer = {
-				source = "passthrough"
 				config = {
 					passthrough = {}
 				}
@@ -630,6 +1357,11 @@ resource "neosync_job" "job1" {
 				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("neosync_job.job1", "id"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.aws_s3.storage_class", "glacier"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.aws_s3.max_in_flight", "10"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.aws_s3.timeout", "30s"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.aws_s3.batch.count", "1000"),
+					resource.TestCheckResourceAttr("neosync_job.job1", "destinations.destination.aws_s3.batch.period", "1m"),
 				),
 			},
 		},
@@ -666,8 +1398,8 @@ resource "neosync_job" "job1" {
 			]
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -677,7 +1409,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -734,8 +1466,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -745,7 +1477,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -796,8 +1528,8 @@ resource "neosync_job" "job1" {
 			connection_id = neosync_connection.source.id
 		}
 	}
-	destinations = [
-		{
+	destinations = {
+		destination = {
 			connection_id = neosync_connection.destination.id
 			postgres = {
 				init_table_schema = false
@@ -807,7 +1539,7 @@ resource "neosync_job" "job1" {
 				}
 			}
 		}
-	]
+	}
 	mappings = [
 		{
 			schema = "public"
@@ -866,3 +1598,107 @@ resource "neosync_job" "job1" {
 		},
 	})
 }
+
+type fakeCreateJobServiceClient struct {
+	mgmtv1alpha1connect.JobServiceClient
+
+	pauseErr error
+}
+
+func (f *fakeCreateJobServiceClient) CreateJob(ctx context.Context, req *connect.Request[mgmtv1alpha1.CreateJobRequest]) (*connect.Response[mgmtv1alpha1.CreateJobResponse], error) {
+	destinations := make([]*mgmtv1alpha1.JobDestination, 0, len(req.Msg.GetDestinations()))
+	for idx, dst := range req.Msg.GetDestinations() {
+		destinations = append(destinations, &mgmtv1alpha1.JobDestination{
+			Id:           fmt.Sprintf("dst-%d", idx+1),
+			ConnectionId: dst.GetConnectionId(),
+			Options:      dst.GetOptions(),
+		})
+	}
+	return connect.NewResponse(&mgmtv1alpha1.CreateJobResponse{Job: &mgmtv1alpha1.Job{
+		Id:                 "job-1",
+		AccountId:          req.Msg.GetAccountId(),
+		Name:               req.Msg.GetJobName(),
+		CronSchedule:       req.Msg.CronSchedule,
+		Source:             req.Msg.GetSource(),
+		Destinations:       destinations,
+		Mappings:           req.Msg.GetMappings(),
+		SyncOptions:        req.Msg.GetSyncOptions(),
+		WorkflowOptions:    req.Msg.GetWorkflowOptions(),
+		VirtualForeignKeys: req.Msg.GetVirtualForeignKeys(),
+	}}), nil
+}
+
+func (f *fakeCreateJobServiceClient) GetJobStatus(ctx context.Context, req *connect.Request[mgmtv1alpha1.GetJobStatusRequest]) (*connect.Response[mgmtv1alpha1.GetJobStatusResponse], error) {
+	return connect.NewResponse(&mgmtv1alpha1.GetJobStatusResponse{Status: mgmtv1alpha1.JobStatus_JOB_STATUS_ENABLED}), nil
+}
+
+func (f *fakeCreateJobServiceClient) PauseJob(ctx context.Context, req *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
+	if f.pauseErr != nil {
+		return nil, f.pauseErr
+	}
+	return connect.NewResponse(&mgmtv1alpha1.PauseJobResponse{}), nil
+}
+
+func Test_JobResource_Create_Paused(t *testing.T) {
+	testcases := []struct {
+		name     string
+		pauseErr error
+		expected types.Bool
+	}{
+		{name: "paused", expected: types.BoolValue(true)},
+		// the job was created, so it must be in the state even though it could not be paused
+		{name: "pause error", pauseErr: errors.New("unavailable"), expected: types.BoolNull()},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			accountId := "account-1"
+			r := &JobResource{client: &fakeCreateJobServiceClient{pauseErr: tc.pauseErr}, accountId: &accountId}
+
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := plan.Set(ctx, &job_model.JobResourceModel{
+				Id:           types.StringUnknown(),
+				Name:         types.StringValue("job"),
+				AccountId:    types.StringNull(),
+				CronSchedule: types.StringValue("0 0 * * *"),
+				Paused:       types.BoolValue(true),
+				JobSource: &job_model.JobSource{Postgres: &job_model.JobSourcePostgresOptions{
+					ConnectionId:                  types.StringValue("source-1"),
+					SubsetByForeignKeyConstraints: types.BoolValue(false),
+				}},
+				Destinations: map[string]*job_model.JobDestination{
+					"stage": {
+						Id:           types.StringUnknown(),
+						ConnectionId: types.StringValue("conn-1"),
+						Postgres:     &job_model.JobDestinationPostgresOptions{InitTableSchema: types.BoolValue(false)},
+					},
+				},
+				Mappings: []*job_model.JobMapping{{
+					Schema: types.StringValue("public"),
+					Table:  types.StringValue("users"),
+					Column: types.StringValue("id"),
+					Transformer: &transformer_model.Transformer{
+						Config: &transformer_model.TransformerConfig{Passthrough: &transformer_model.TransformerEmpty{}},
+					},
+				}},
+			})
+			require.False(t, diags.HasError(), diags)
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+			require.Equal(t, tc.pauseErr != nil, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var state job_model.JobResourceModel
+			diags = resp.State.Get(ctx, &state)
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, "job-1", state.Id.ValueString())
+			require.Equal(t, "dst-1", state.Destinations["stage"].Id.ValueString())
+			require.Equal(t, tc.expected, state.Paused)
+		})
+	}
+}
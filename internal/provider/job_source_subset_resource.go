@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
+)
+
+var _ resource.Resource = &JobSourceSubsetResource{}
+var _ resource.ResourceWithImportState = &JobSourceSubsetResource{}
+
+func NewJobSourceSubsetResource() resource.Resource {
+	return &JobSourceSubsetResource{}
+}
+
+type JobSourceSubsetResource struct {
+	client mgmtv1alpha1connect.JobServiceClient
+}
+
+func (r *JobSourceSubsetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_source_subset"
+}
+
+func (r *JobSourceSubsetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	subsetTableAttributes := map[string]schema.Attribute{
+		"table": schema.StringAttribute{
+			Description: "The name of the table",
+			Required:    true,
+		},
+		"where_clause": schema.StringAttribute{
+			Description: "A where clause that will be used to subset the table during sync",
+			Optional:    true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Job source subset resource. Manages the where clauses of a job's postgres, mysql, mssql or dynamodb source, independently from the neosync_job resource. " +
+			"The job must have manage_subsets set to false so that it does not remove the subsets",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The unique identifier of the job source subset, which is the same as the job id",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"job_id": schema.StringAttribute{
+				Description:   "The unique identifier of the job whose source is subset",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"subset_by_foreign_key_constraints": schema.BoolAttribute{
+				Description: "Whether or not to subset the source tables by foreign key constraints. Not supported by dynamodb sources",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"schemas": schema.ListNestedAttribute{
+				Description: "A list of schemas and the where clauses of their tables. Used by postgres, mysql and mssql sources. Omit it instead of setting an empty list to remove the subsets",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schema": schema.StringAttribute{
+							Description: "The name of the schema",
+							Required:    true,
+						},
+						"tables": schema.ListNestedAttribute{
+							Description: "A list of tables and their where clauses within the defined schema",
+							Required:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: subsetTableAttributes,
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("tables")),
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"tables": schema.ListNestedAttribute{
+				Description: "A list of tables and their PartiQL where clauses. Used by dynamodb sources. Omit it instead of setting an empty list to remove the subsets",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: subsetTableAttributes,
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *JobSourceSubsetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ConfigData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ConfigData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.JobClient
+}
+
+func (r *JobSourceSubsetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data job_model.JobSourceSubsetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newModel, diags := r.set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "created job source subset")
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *JobSourceSubsetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data job_model.JobSourceSubsetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobResp, err := r.client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: data.JobId.ValueString(),
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get job", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "got job")
+
+	newModel := job_model.JobSourceSubsetResourceModel{}
+	err = newModel.FromDto(data.JobId.ValueString(), jobResp.Msg.GetJob().GetSource())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to convert dto to state", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "mapped job source subset to model")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newModel)...)
+}
+
+func (r *JobSourceSubsetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planModel job_model.JobSourceSubsetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newModel, diags := r.set(ctx, &planModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "updated job source subset")
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *JobSourceSubsetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data job_model.JobSourceSubsetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := setJobSourceSubsets(ctx, r.client, data.JobId.ValueString(), func(source *mgmtv1alpha1.JobSource) (*mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest, error) {
+		return job_model.NewClearJobSourceSubsetsRequest(data.JobId.ValueString(), source)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete job source subset", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "deleted job source subset")
+}
+
+func (r *JobSourceSubsetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError("Unable to import", "must provide the job id")
+		return
+	}
+
+	jobResp, err := r.client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{
+		Id: req.ID,
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get job", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "retrieved job during import")
+
+	var data job_model.JobSourceSubsetResourceModel
+	err = data.FromDto(req.ID, jobResp.Msg.GetJob().GetSource())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to map job source subset to model", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "mapped job source subset to model during import")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Saves the subsets to the job's source and returns the saved subsets
+func (r *JobSourceSubsetResource) set(ctx context.Context, data *job_model.JobSourceSubsetResourceModel) (*job_model.JobSourceSubsetResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	job, err := setJobSourceSubsets(ctx, r.client, data.JobId.ValueString(), data.ToDto)
+	if err != nil {
+		diags.AddError("Unable to set job source subsets", err.Error())
+		return nil, diags
+	}
+
+	newModel := &job_model.JobSourceSubsetResourceModel{}
+	err = newModel.FromDto(data.JobId.ValueString(), job.GetSource())
+	if err != nil {
+		diags.AddError("unable to convert dto to state", err.Error())
+		return nil, diags
+	}
+	return newModel, diags
+}
+
+// Builds the subset request from the job's current source and saves it. Returns the updated job.
+func setJobSourceSubsets(
+	ctx context.Context,
+	client mgmtv1alpha1connect.JobServiceClient,
+	jobId string,
+	toDto func(source *mgmtv1alpha1.JobSource) (*mgmtv1alpha1.SetJobSourceSqlConnectionSubsetsRequest, error),
+) (*mgmtv1alpha1.Job, error) {
+	unlock := lockJobSource(jobId)
+	defer unlock()
+
+	jobResp, err := client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobId}))
+	if err != nil {
+		return nil, err
+	}
+
+	subsetReq, err := toDto(jobResp.Msg.GetJob().GetSource())
+	if err != nil {
+		return nil, err
+	}
+
+	subsetResp, err := client.SetJobSourceSqlConnectionSubsets(ctx, connect.NewRequest(subsetReq))
+	if err != nil {
+		return nil, err
+	}
+	return subsetResp.Msg.GetJob(), nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_JobSourceSubset(t *testing.T) {
+	name := acctest.RandString(10)
+
+	getConfig := func(whereClause string) string {
+		return fmt.Sprintf(`
+resource "neosync_connection" "source" {
+	name = "%s-src"
+
+	postgres = {
+		url = "test-url"
+	}
+}
+resource "neosync_connection" "destination" {
+	name = "%s-dest"
+
+	postgres = {
+		url = "test-url2"
+	}
+}
+
+resource "neosync_job" "job1" {
+	name = "%s"
+	manage_subsets = false
+	source = {
+		postgres = {
+			connection_id = neosync_connection.source.id
+		}
+	}
+	destinations = {
+		destination = {
+			connection_id = neosync_connection.destination.id
+			postgres = {
+				init_table_schema = false
+			}
+		}
+	}
+}
+
+resource "neosync_job_source_subset" "subset" {
+	job_id = neosync_job.job1.id
+	subset_by_foreign_key_constraints = true
+	schemas = [
+		{
+			schema = "public"
+			tables = [
+				{
+					table = "users"
+					where_clause = "%s"
+				}
+			]
+		}
+	]
+}
+	`, name, name, name, whereClause)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getConfig("id > 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("neosync_job_source_subset.subset", "id", "neosync_job.job1", "id"),
+					resource.TestCheckResourceAttr("neosync_job_source_subset.subset", "subset_by_foreign_key_constraints", "true"),
+					resource.TestCheckResourceAttr("neosync_job_source_subset.subset", "schemas.0.tables.0.where_clause", "id > 1"),
+					resource.TestCheckNoResourceAttr("neosync_job.job1", "source.postgres.schemas"),
+				),
+			},
+			{
+				Config: getConfig("id > 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("neosync_job_source_subset.subset", "schemas.0.tables.0.where_clause", "id > 2"),
+				),
+			},
+			{
+				ResourceName:      "neosync_job_source_subset.subset",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
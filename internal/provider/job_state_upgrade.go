@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
+)
+
+func (r *JobResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// version 0 stored destinations as a list, version 1 stores them in a map
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil || req.RawState.JSON == nil {
+					resp.Diagnostics.AddError("Unable to upgrade job state", "the prior state is missing")
+					return
+				}
+
+				upgraded, err := upgradeJobStateV0(req.RawState.JSON)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade job state", err.Error())
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
+
+// Converts the destinations list into a map keyed by each destination's connection id.
+// Destinations that share a connection id are given a numeric suffix.
+func upgradeJobStateV0(raw []byte) ([]byte, error) {
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal prior job state: %w", err)
+	}
+
+	rawDestinations, ok := state["destinations"]
+	if !ok {
+		return raw, nil
+	}
+
+	var destinations []map[string]json.RawMessage
+	if err := json.Unmarshal(rawDestinations, &destinations); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal prior job destinations: %w", err)
+	}
+	if destinations == nil {
+		return raw, nil
+	}
+
+	keyed := make(map[string]map[string]json.RawMessage, len(destinations))
+	for idx, destination := range destinations {
+		var connectionId string
+		if rawConnectionId, ok := destination["connection_id"]; ok {
+			if err := json.Unmarshal(rawConnectionId, &connectionId); err != nil {
+				return nil, fmt.Errorf("unable to unmarshal connection id of destination %d: %w", idx, err)
+			}
+		}
+		if connectionId == "" {
+			connectionId = fmt.Sprintf("destination-%d", idx)
+		}
+		keyed[job_model.UniqueDestinationKey(keyed, connectionId)] = destination
+	}
+
+	upgradedDestinations, err := json.Marshal(keyed)
+	if err != nil {
+		return nil, err
+	}
+	state["destinations"] = upgradedDestinations
+	return json.Marshal(state)
+}
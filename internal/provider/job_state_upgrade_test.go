@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_upgradeJobStateV0(t *testing.T) {
+	upgraded, err := upgradeJobStateV0([]byte(`{
+		"id": "job-1",
+		"destinations": [
+			{"id": "dst-1", "connection_id": "conn-1", "postgres": null},
+			{"id": "dst-2", "connection_id": "conn-2", "postgres": null},
+			{"id": "dst-3", "connection_id": "conn-1", "postgres": null}
+		]
+	}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"id": "job-1",
+		"destinations": {
+			"conn-1": {"id": "dst-1", "connection_id": "conn-1", "postgres": null},
+			"conn-2": {"id": "dst-2", "connection_id": "conn-2", "postgres": null},
+			"conn-1-2": {"id": "dst-3", "connection_id": "conn-1", "postgres": null}
+		}
+	}`, string(upgraded))
+}
+
+func Test_upgradeJobStateV0_NullDestinations(t *testing.T) {
+	upgraded, err := upgradeJobStateV0([]byte(`{"id": "job-1", "destinations": null}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id": "job-1", "destinations": null}`, string(upgraded))
+}
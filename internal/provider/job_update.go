@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
+)
+
+// Sends each of the requests that make up a job update. Requests that are not set are skipped.
+func applyJobUpdate(
+	ctx context.Context,
+	client mgmtv1alpha1connect.JobServiceClient,
+	updateJobRequest *job_model.UpdateJobRequest,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if updateJobRequest.UpdateJobScheduleRequest != nil {
+		_, err := client.UpdateJobSchedule(ctx, connect.NewRequest(updateJobRequest.UpdateJobScheduleRequest))
+		if err != nil {
+			diags.AddError("unable to update job schedule", err.Error())
+			return diags
+		}
+	}
+
+	if updateJobRequest.PauseJobRequest != nil {
+		_, err := client.PauseJob(ctx, connect.NewRequest(updateJobRequest.PauseJobRequest))
+		if err != nil {
+			diags.AddError("unable to pause or resume job", err.Error())
+			return diags
+		}
+	}
+
+	if updateJobRequest.UpdateJobSourceConnectionRequest != nil {
+		err := updateJobSourceConnection(
+			ctx,
+			client,
+			updateJobRequest.UpdateJobSourceConnectionRequest,
+			updateJobRequest.KeepCurrentMappings,
+			updateJobRequest.KeepCurrentSubsets,
+		)
+		if err != nil {
+			diags.AddError("unable to update job source connection", err.Error())
+			return diags
+		}
+	}
+
+	if updateJobRequest.CreateJobDestinationConnectionsRequest != nil {
+		_, err := client.CreateJobDestinationConnections(ctx, connect.NewRequest(updateJobRequest.CreateJobDestinationConnectionsRequest))
+		if err != nil {
+			diags.AddError("unable to create job destination connections", err.Error())
+			return diags
+		}
+	}
+
+	for _, req := range updateJobRequest.UpdateJobDestinationConnectionRequests {
+		_, err := client.UpdateJobDestinationConnection(ctx, connect.NewRequest(req))
+		if err != nil {
+			diags.AddError("unable to update job destination connection", err.Error())
+			return diags
+		}
+	}
+
+	for _, req := range updateJobRequest.DeleteJobDestinationConnectionRequests {
+		_, err := client.DeleteJobDestinationConnection(ctx, connect.NewRequest(req))
+		if err != nil {
+			diags.AddError("unable to delete job destination connection", err.Error())
+			return diags
+		}
+	}
+
+	if updateJobRequest.SetJobSyncOptionsRequest != nil {
+		_, err := client.SetJobSyncOptions(ctx, connect.NewRequest(updateJobRequest.SetJobSyncOptionsRequest))
+		if err != nil {
+			diags.AddError("unable to update job sync options", err.Error())
+			return diags
+		}
+	}
+
+	if updateJobRequest.SetJobWorkflowOptionsRequest != nil {
+		_, err := client.SetJobWorkflowOptions(ctx, connect.NewRequest(updateJobRequest.SetJobWorkflowOptionsRequest))
+		if err != nil {
+			diags.AddError("unable to update job workflow options", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Updating the source connection rewrites all of the job's mappings and subsets,
+// so it is serialized with changes made by neosync_job_mapping and neosync_job_source_subset resources.
+func updateJobSourceConnection(
+	ctx context.Context,
+	client mgmtv1alpha1connect.JobServiceClient,
+	req *mgmtv1alpha1.UpdateJobSourceConnectionRequest,
+	keepCurrentMappings bool,
+	keepCurrentSubsets bool,
+) error {
+	unlock := lockJobSource(req.GetId())
+	defer unlock()
+
+	if keepCurrentMappings || keepCurrentSubsets {
+		jobResp, err := client.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.GetId()}))
+		if err != nil {
+			return err
+		}
+		if keepCurrentMappings {
+			req.Mappings = jobResp.Msg.GetJob().GetMappings()
+		}
+		if keepCurrentSubsets {
+			job_model.CopyJobSourceSubsets(req.GetSource(), jobResp.Msg.GetJob().GetSource())
+		}
+	}
+
+	_, err := client.UpdateJobSourceConnection(ctx, connect.NewRequest(req))
+	return err
+}
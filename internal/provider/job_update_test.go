@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
+	transformer_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/transformers"
+	"github.com/stretchr/testify/require"
+)
+
+// Records the job service rpcs that were called. Any rpc that is not overridden panics.
+type fakeJobServiceClient struct {
+	mgmtv1alpha1connect.JobServiceClient
+
+	calls        []string
+	sentMappings []*mgmtv1alpha1.JobMapping
+	sentSource   *mgmtv1alpha1.JobSource
+}
+
+func (f *fakeJobServiceClient) UpdateJobSchedule(ctx context.Context, req *connect.Request[mgmtv1alpha1.UpdateJobScheduleRequest]) (*connect.Response[mgmtv1alpha1.UpdateJobScheduleResponse], error) {
+	f.calls = append(f.calls, "UpdateJobSchedule")
+	return connect.NewResponse(&mgmtv1alpha1.UpdateJobScheduleResponse{}), nil
+}
+
+func (f *fakeJobServiceClient) GetJob(ctx context.Context, req *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error) {
+	f.calls = append(f.calls, "GetJob")
+	whereClause := "managed_elsewhere = true"
+	return connect.NewResponse(&mgmtv1alpha1.GetJobResponse{Job: &mgmtv1alpha1.Job{
+		Id: req.Msg.GetId(),
+		Source: &mgmtv1alpha1.JobSource{Options: &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_Postgres{Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+				ConnectionId:                  "source-1",
+				SubsetByForeignKeyConstraints: true,
+				Schemas: []*mgmtv1alpha1.PostgresSourceSchemaOption{{
+					Schema: "public",
+					Tables: []*mgmtv1alpha1.PostgresSourceTableOption{{Table: "users", WhereClause: &whereClause}},
+				}},
+			}},
+		}},
+		Mappings: []*mgmtv1alpha1.JobMapping{{Schema: "public", Table: "users", Column: "managed_elsewhere"}},
+	}}), nil
+}
+
+func (f *fakeJobServiceClient) UpdateJobSourceConnection(ctx context.Context, req *connect.Request[mgmtv1alpha1.UpdateJobSourceConnectionRequest]) (*connect.Response[mgmtv1alpha1.UpdateJobSourceConnectionResponse], error) {
+	f.calls = append(f.calls, "UpdateJobSourceConnection")
+	f.sentMappings = req.Msg.GetMappings()
+	f.sentSource = req.Msg.GetSource()
+	return connect.NewResponse(&mgmtv1alpha1.UpdateJobSourceConnectionResponse{}), nil
+}
+
+func (f *fakeJobServiceClient) CreateJobDestinationConnections(ctx context.Context, req *connect.Request[mgmtv1alpha1.CreateJobDestinationConnectionsRequest]) (*connect.Response[mgmtv1alpha1.CreateJobDestinationConnectionsResponse], error) {
+	for _, dst := range req.Msg.GetDestinations() {
+		f.calls = append(f.calls, "CreateJobDestinationConnections:"+dst.GetConnectionId())
+	}
+	return connect.NewResponse(&mgmtv1alpha1.CreateJobDestinationConnectionsResponse{}), nil
+}
+
+func (f *fakeJobServiceClient) UpdateJobDestinationConnection(ctx context.Context, req *connect.Request[mgmtv1alpha1.UpdateJobDestinationConnectionRequest]) (*connect.Response[mgmtv1alpha1.UpdateJobDestinationConnectionResponse], error) {
+	f.calls = append(f.calls, "UpdateJobDestinationConnection:"+req.Msg.GetDestinationId())
+	return connect.NewResponse(&mgmtv1alpha1.UpdateJobDestinationConnectionResponse{}), nil
+}
+
+func (f *fakeJobServiceClient) DeleteJobDestinationConnection(ctx context.Context, req *connect.Request[mgmtv1alpha1.DeleteJobDestinationConnectionRequest]) (*connect.Response[mgmtv1alpha1.DeleteJobDestinationConnectionResponse], error) {
+	f.calls = append(f.calls, "DeleteJobDestinationConnection:"+req.Msg.GetDestinationId())
+	return connect.NewResponse(&mgmtv1alpha1.DeleteJobDestinationConnectionResponse{}), nil
+}
+
+func (f *fakeJobServiceClient) SetJobSyncOptions(ctx context.Context, req *connect.Request[mgmtv1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobSyncOptionsResponse], error) {
+	f.calls = append(f.calls, "SetJobSyncOptions")
+	return connect.NewResponse(&mgmtv1alpha1.SetJobSyncOptionsResponse{}), nil
+}
+
+func (f *fakeJobServiceClient) SetJobWorkflowOptions(ctx context.Context, req *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest]) (*connect.Response[mgmtv1alpha1.SetJobWorkflowOptionsResponse], error) {
+	f.calls = append(f.calls, "SetJobWorkflowOptions")
+	return connect.NewResponse(&mgmtv1alpha1.SetJobWorkflowOptionsResponse{}), nil
+}
+
+func (f *fakeJobServiceClient) PauseJob(ctx context.Context, req *connect.Request[mgmtv1alpha1.PauseJobRequest]) (*connect.Response[mgmtv1alpha1.PauseJobResponse], error) {
+	f.calls = append(f.calls, fmt.Sprintf("PauseJob:%t", req.Msg.GetPause()))
+	return connect.NewResponse(&mgmtv1alpha1.PauseJobResponse{}), nil
+}
+
+func Test_applyJobUpdate(t *testing.T) {
+	testcases := []struct {
+		name     string
+		plan     func(plan *job_model.JobResourceModel)
+		expected []string
+	}{
+		{
+			name:     "no changes",
+			plan:     func(plan *job_model.JobResourceModel) {},
+			expected: nil,
+		},
+		{
+			name: "schedule only",
+			plan: func(plan *job_model.JobResourceModel) {
+				plan.CronSchedule = types.StringValue("0 1 * * *")
+			},
+			expected: []string{"UpdateJobSchedule"},
+		},
+		{
+			name: "unknown schedule",
+			plan: func(plan *job_model.JobResourceModel) {
+				plan.CronSchedule = types.StringUnknown()
+			},
+			expected: nil,
+		},
+		{
+			name: "mappings",
+			plan: func(plan *job_model.JobResourceModel) {
+				plan.Mappings = append(plan.Mappings, newTestJobMapping("email"))
+			},
+			expected: []string{"UpdateJobSourceConnection"},
+		},
+		{
+			name: "source",
+			plan: func(plan *job_model.JobResourceModel) {
+				plan.JobSource.Postgres.ConnectionId = types.StringValue("source-2")
+			},
+			expected: []string{"UpdateJobSourceConnection"},
+		},
+		{
+			name: "one destination",
+			plan: func(plan *job_model.JobResourceModel) {
+				plan.Destinations["second"].Postgres.InitTableSchema = types.BoolValue(true)
+			},
+			expected: []string{"UpdateJobDestinationConnection:dst-2"},
+		},
+		{
+			name: "added destination",
+			plan: func(plan *job_model.JobResourceModel) {
+				plan.Destinations["third"] = &job_model.JobDestination{
+					Id:           types.StringUnknown(),
+					ConnectionId: types.StringValue("conn-3"),
+					Postgres:     &job_model.JobDestinationPostgresOptions{InitTableSchema: types.BoolValue(false)},
+				}
+			},
+			expected: []string{"CreateJobDestinationConnections:conn-3"},
+		},
+		{
+			name: "removed destination",
+			plan: func(plan *job_model.JobResourceModel) {
+				delete(plan.Destinations, "second")
+			},
+			expected: []string{"DeleteJobDestinationConnection:dst-2"},
+		},
+		{
+			name: "schedule and destination",
+			plan: func(plan *job_model.JobResourceModel) {
+				plan.CronSchedule = types.StringValue("0 1 * * *")
+				plan.Destinations["first"].ConnectionId = types.StringValue("conn-3")
+			},
+			expected: []string{"UpdateJobSchedule", "UpdateJobDestinationConnection:dst-1"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := newTestJobResourceModel()
+			plan := newTestJobResourceModel()
+			tc.plan(plan)
+
+			updateJobRequest, err := state.ToUpdateJobDto(plan, plan.Id.ValueString())
+			require.NoError(t, err)
+
+			client := &fakeJobServiceClient{}
+			diags := applyJobUpdate(context.Background(), client, updateJobRequest)
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, tc.expected, client.calls)
+		})
+	}
+}
+
+func Test_applyJobUpdate_JobOptions(t *testing.T) {
+	updateJobRequest := &job_model.UpdateJobRequest{
+		SetJobSyncOptionsRequest:     &mgmtv1alpha1.SetJobSyncOptionsRequest{Id: "job-1"},
+		SetJobWorkflowOptionsRequest: &mgmtv1alpha1.SetJobWorkflowOptionsRequest{Id: "job-1"},
+		PauseJobRequest:              &mgmtv1alpha1.PauseJobRequest{Id: "job-1", Pause: true},
+	}
+
+	client := &fakeJobServiceClient{}
+	diags := applyJobUpdate(context.Background(), client, updateJobRequest)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, []string{"PauseJob:true", "SetJobSyncOptions", "SetJobWorkflowOptions"}, client.calls)
+}
+
+func Test_applyJobUpdate_KeepCurrentMappings(t *testing.T) {
+	state := newTestJobResourceModel()
+	state.ManageMappings = types.BoolValue(false)
+	state.Mappings = nil
+	plan := newTestJobResourceModel()
+	plan.ManageMappings = types.BoolValue(false)
+	plan.Mappings = nil
+	plan.JobSource.Postgres.SubsetByForeignKeyConstraints = types.BoolValue(true)
+
+	updateJobRequest, err := state.ToUpdateJobDto(plan, plan.Id.ValueString())
+	require.NoError(t, err)
+	require.True(t, updateJobRequest.KeepCurrentMappings)
+
+	client := &fakeJobServiceClient{}
+	diags := applyJobUpdate(context.Background(), client, updateJobRequest)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, client.sentMappings, 1)
+	require.Equal(t, "managed_elsewhere", client.sentMappings[0].GetColumn())
+}
+
+func Test_applyJobUpdate_KeepCurrentSubsets(t *testing.T) {
+	state := newTestJobResourceModel()
+	state.ManageSubsets = types.BoolValue(false)
+	state.JobSource.Postgres.SubsetByForeignKeyConstraints = types.BoolNull()
+	plan := newTestJobResourceModel()
+	plan.ManageSubsets = types.BoolValue(false)
+	plan.JobSource.Postgres.SubsetByForeignKeyConstraints = types.BoolUnknown()
+	plan.JobSource.Postgres.ColumnRemovalStrategy = &job_model.PostgresColumnRemovalStrategy{
+		HaltJob: &job_model.PostgresHaltJobColumnRemovalStrategy{},
+	}
+
+	updateJobRequest, err := state.ToUpdateJobDto(plan, plan.Id.ValueString())
+	require.NoError(t, err)
+	require.True(t, updateJobRequest.KeepCurrentSubsets)
+
+	client := &fakeJobServiceClient{}
+	diags := applyJobUpdate(context.Background(), client, updateJobRequest)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, []string{"GetJob", "UpdateJobSourceConnection"}, client.calls)
+
+	postgres := client.sentSource.GetOptions().GetPostgres()
+	require.NotNil(t, postgres.GetColumnRemovalStrategy().GetHaltJob())
+	require.True(t, postgres.GetSubsetByForeignKeyConstraints())
+	require.Len(t, postgres.GetSchemas(), 1)
+	require.Equal(t, "managed_elsewhere = true", postgres.GetSchemas()[0].GetTables()[0].GetWhereClause())
+	require.Len(t, client.sentMappings, 1)
+	require.Equal(t, "id", client.sentMappings[0].GetColumn())
+}
+
+func newTestJobResourceModel() *job_model.JobResourceModel {
+	return &job_model.JobResourceModel{
+		Id:           types.StringValue("job-1"),
+		Name:         types.StringValue("test"),
+		AccountId:    types.StringValue("account-1"),
+		CronSchedule: types.StringValue("0 0 * * *"),
+		Paused:       types.BoolValue(false),
+		JobSource: &job_model.JobSource{
+			Postgres: &job_model.JobSourcePostgresOptions{
+				ConnectionId:                  types.StringValue("source-1"),
+				SubsetByForeignKeyConstraints: types.BoolValue(false),
+			},
+		},
+		Destinations: map[string]*job_model.JobDestination{
+			"first": {
+				Id:           types.StringValue("dst-1"),
+				ConnectionId: types.StringValue("conn-1"),
+				Postgres:     &job_model.JobDestinationPostgresOptions{InitTableSchema: types.BoolValue(false)},
+			},
+			"second": {
+				Id:           types.StringValue("dst-2"),
+				ConnectionId: types.StringValue("conn-2"),
+				Postgres:     &job_model.JobDestinationPostgresOptions{InitTableSchema: types.BoolValue(false)},
+			},
+		},
+		Mappings: []*job_model.JobMapping{newTestJobMapping("id")},
+		SyncOptions: &job_model.ActivityOptions{
+			ScheduleToCloseTimeout: types.Int64Null(),
+			StartToCloseTimeout:    types.Int64Value(60),
+			RetryPolicy:            &job_model.RetryPolicy{MaximumAttempts: types.Int64Value(2)},
+		},
+	}
+}
+
+func newTestJobMapping(column string) *job_model.JobMapping {
+	return &job_model.JobMapping{
+		Schema: types.StringValue("public"),
+		Table:  types.StringValue("users"),
+		Column: types.StringValue(column),
+		Transformer: &transformer_model.Transformer{
+			Config: &transformer_model.TransformerConfig{Passthrough: &transformer_model.TransformerEmpty{}},
+		},
+	}
+}
@@ -62,10 +62,11 @@ func (p *NeosyncProvider) Schema(ctx context.Context, req provider.SchemaRequest
 }
 
 type ConfigData struct {
-	AccountId         *string
-	ConnectionClient  mgmtv1alpha1connect.ConnectionServiceClient
-	JobClient         mgmtv1alpha1connect.JobServiceClient
-	TransformerClient mgmtv1alpha1connect.TransformersServiceClient
+	AccountId            *string
+	ConnectionClient     mgmtv1alpha1connect.ConnectionServiceClient
+	ConnectionDataClient mgmtv1alpha1connect.ConnectionDataServiceClient
+	JobClient            mgmtv1alpha1connect.JobServiceClient
+	TransformerClient    mgmtv1alpha1connect.TransformersServiceClient
 }
 
 func (p *NeosyncProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -145,6 +146,10 @@ func (p *NeosyncProvider) Configure(ctx context.Context, req provider.ConfigureR
 			httpclient,
 			endpoint,
 		),
+		ConnectionDataClient: mgmtv1alpha1connect.NewConnectionDataServiceClient(
+			httpclient,
+			endpoint,
+		),
 		JobClient: mgmtv1alpha1connect.NewJobServiceClient(
 			httpclient,
 			endpoint,
@@ -167,6 +172,8 @@ func (p *NeosyncProvider) Resources(ctx context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		NewConnectionResource,
 		NewJobResource,
+		NewJobMappingResource,
+		NewJobSourceSubsetResource,
 		NewUserDefinedTransformerResource,
 		NewJobHookResource,
 	}
@@ -175,6 +182,8 @@ func (p *NeosyncProvider) Resources(ctx context.Context) []func() resource.Resou
 func (p *NeosyncProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewConnectionDataSource,
+		NewConnectionsDataSource,
+		NewConnectionForeignKeysDataSource,
 		NewJobDataSource,
 		NewUserDefinedTransformerDataSource,
 		NewSystemTransformerDataSource,
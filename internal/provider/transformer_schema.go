@@ -5,12 +5,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var (
 	transformerSchema = schema.SingleNestedAttribute{
 		Description: "This config object consists of the matching configuration defined with the source specified.",
 		Required:    true,
+		Validators:  []validator.Object{exactlyOneOfAttributes()},
 		Attributes: map[string]schema.Attribute{
 			"generate_email": schema.SingleNestedAttribute{
 				Description: "",
@@ -248,20 +248,33 @@ func (r *UserDefinedTransformerResource) Delete(ctx context.Context, req resourc
 
 func (r *UserDefinedTransformerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	if req.ID == "" {
-		resp.Diagnostics.AddError("Unable to import", "must provide ID")
+		resp.Diagnostics.AddError("Unable to import", "must provide ID or name:<name>")
 		return
 	}
 
-	transResp, err := r.client.GetUserDefinedTransformerById(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserDefinedTransformerByIdRequest{
-		TransformerId: req.ID,
-	}))
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to get transformer", err.Error())
-		return
+	var transformer *mgmtv1alpha1.UserDefinedTransformer
+	if name, ok := parseImportName(req.ID); ok {
+		if r.accountId == nil {
+			resp.Diagnostics.AddError("Unable to import", "must configure the provider with an account id to import by name")
+			return
+		}
+		namedTransformer, err := getUserDefinedTransformerByName(ctx, r.client, *r.accountId, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get transformer by name", err.Error())
+			return
+		}
+		transformer = namedTransformer
+	} else {
+		transResp, err := r.client.GetUserDefinedTransformerById(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserDefinedTransformerByIdRequest{
+			TransformerId: req.ID,
+		}))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get transformer", err.Error())
+			return
+		}
+		transformer = transResp.Msg.Transformer
 	}
 
-	transformer := transResp.Msg.Transformer
-
 	updatedModel, err := fromTransformerDto(transformer)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to convert dto to transformer model", err.Error())
@@ -272,6 +285,24 @@ func (r *UserDefinedTransformerResource) ImportState(ctx context.Context, req re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedModel)...)
 }
 
+// Finds the user defined transformer with the given name in the account.
+// Returns an error if no transformer or more than one transformer has that name.
+func getUserDefinedTransformerByName(
+	ctx context.Context,
+	client mgmtv1alpha1connect.TransformersServiceClient,
+	accountId string,
+	name string,
+) (*mgmtv1alpha1.UserDefinedTransformer, error) {
+	transResp, err := client.GetUserDefinedTransformers(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserDefinedTransformersRequest{
+		AccountId: accountId,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return findOneByName(transResp.Msg.GetTransformers(), "user defined transformer", accountId, name)
+}
+
 func fromTransformerDto(dto *mgmtv1alpha1.UserDefinedTransformer) (*UserDefinedTransformerResourceModel, error) {
 	if dto == nil {
 		return nil, errors.New("dto was nil")
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	job_model "github.com/nucleuscloud/terraform-provider-neosync/internal/models/jobs"
+)
+
+var _ validator.Object = exactlyOneOfAttributesValidator{}
+
+// Validates that exactly one of the given nested attributes of an object has been configured.
+// If no attribute names are provided, every nested attribute of the object is considered.
+// This is used for the objects that map to a protobuf oneof.
+func exactlyOneOfAttributes(attributeNames ...string) validator.Object {
+	return exactlyOneOfAttributesValidator{attributeNames: attributeNames}
+}
+
+type exactlyOneOfAttributesValidator struct {
+	attributeNames []string
+}
+
+func (v exactlyOneOfAttributesValidator) Description(ctx context.Context) string {
+	if len(v.attributeNames) == 0 {
+		return "Exactly one of the nested attributes must be configured"
+	}
+	return fmt.Sprintf("Exactly one of these attributes must be configured: [%s]", strings.Join(v.attributeNames, ","))
+}
+
+func (v exactlyOneOfAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v exactlyOneOfAttributesValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+	names := v.attributeNames
+	if len(names) == 0 {
+		names = make([]string, 0, len(attributes))
+		for name := range attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	configured := []string{}
+	for _, name := range names {
+		value, ok := attributes[name]
+		if !ok || value.IsNull() {
+			continue
+		}
+		if value.IsUnknown() {
+			// the final value is not known until apply, so nothing can be said yet
+			return
+		}
+		configured = append(configured, name)
+	}
+
+	switch len(configured) {
+	case 1:
+		return
+	case 0:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Exactly one of these attributes must be configured: [%s]", strings.Join(names, ",")),
+		)
+	default:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Only one of these attributes may be configured, but found: [%s]", strings.Join(configured, ",")),
+		)
+	}
+}
+
+var _ validator.List = uniqueJobMappingsValidator{}
+
+// Validates that each schema, table and column is only mapped once.
+func uniqueJobMappings() validator.List {
+	return uniqueJobMappingsValidator{}
+}
+
+type uniqueJobMappingsValidator struct{}
+
+func (v uniqueJobMappingsValidator) Description(ctx context.Context) string {
+	return "Each schema, table and column combination may only be mapped once"
+}
+
+func (v uniqueJobMappingsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueJobMappingsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[job_model.JobMappingKey]int{}
+	for idx, element := range req.ConfigValue.Elements() {
+		key, ok := jobMappingKey(element)
+		if !ok {
+			continue
+		}
+		if firstIdx, ok := seen[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(idx),
+				"Duplicate Job Mapping",
+				fmt.Sprintf("%s has already been mapped at index %d. Each column may only be mapped once", key, firstIdx),
+			)
+			continue
+		}
+		seen[key] = idx
+	}
+}